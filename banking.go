@@ -0,0 +1,98 @@
+package govalidator
+
+import (
+	"errors"
+	"strings"
+)
+
+// IBANLengths maps ISO3166 alpha-2 codes (see ISO3166List) to the length of their IBANs,
+// based on the SWIFT IBAN registry https://www.swift.com/standards/data-standards/iban
+var IBANLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16, "BG": 22,
+	"BH": 22, "BR": 29, "BY": 28, "CH": 21, "CR": 22, "CY": 28, "CZ": 24, "DE": 22,
+	"DJ": 27, "DK": 18, "DO": 28, "EE": 20, "EG": 29, "ES": 24, "FI": 18, "FK": 18,
+	"FO": 18, "FR": 27, "GB": 22, "GE": 22, "GI": 23, "GL": 18, "GR": 27, "GT": 28,
+	"HR": 21, "HU": 28, "IE": 22, "IL": 23, "IQ": 23, "IS": 26, "IT": 27, "JO": 30,
+	"KW": 30, "KZ": 20, "LB": 28, "LC": 32, "LI": 21, "LT": 20, "LU": 20, "LV": 21,
+	"LY": 25, "MC": 27, "MD": 24, "ME": 22, "MK": 19, "MN": 20, "MR": 27, "MT": 31,
+	"MU": 30, "NL": 18, "NO": 15, "OM": 23, "PK": 24, "PL": 28, "PS": 29, "PT": 25,
+	"QA": 29, "RO": 24, "RS": 22, "RU": 33, "SA": 24, "SC": 31, "SD": 18, "SE": 24,
+	"SI": 19, "SK": 24, "SM": 27, "SO": 23, "ST": 25, "SV": 28, "TL": 23, "TN": 24,
+	"TR": 26, "UA": 29, "VA": 22, "VG": 24, "YE": 30,
+}
+
+// IBANInfo is an IBAN split into its parts by ParseIBAN.
+type IBANInfo struct {
+	CountryCode string
+	CheckDigits string
+	BBAN        string
+}
+
+// String returns the IBAN in its electronic format e.g. GB82WEST12345698765432.
+func (i *IBANInfo) String() string {
+	return i.CountryCode + i.CheckDigits + i.BBAN
+}
+
+// ParseIBAN parses an International Bank Account Number in its electronic or print
+// format (e.g. GB82 WEST 1234 5698 7654 32), checking its length for the country and its mod-97 check digits.
+func ParseIBAN(str string) (*IBANInfo, error) {
+	iban := strings.ToUpper(strings.Replace(str, " ", "", -1))
+	if len(iban) < 5 || !rxIBAN.MatchString(iban) {
+		return nil, errors.New("invalid IBAN format")
+	}
+	length, ok := IBANLengths[iban[:2]]
+	if !ok {
+		return nil, errors.New("unsupported IBAN country " + iban[:2])
+	}
+	if len(iban) != length {
+		return nil, errors.New("invalid IBAN length for " + iban[:2])
+	}
+	if ibanMod97(iban[4:]+iban[:4]) != 1 {
+		return nil, errors.New("invalid IBAN check digits")
+	}
+	return &IBANInfo{iban[:2], iban[2:4], iban[4:]}, nil
+}
+
+// ibanMod97 returns the number formed by replacing the letters with 10 to 35 modulo 97.
+func ibanMod97(str string) int {
+	mod := 0
+	for _, c := range str {
+		if c >= 'A' {
+			mod = (mod*100 + int(c-'A') + 10) % 97
+		} else {
+			mod = (mod*10 + int(c-'0')) % 97
+		}
+	}
+	return mod
+}
+
+// IsIBAN checks if the string is an International Bank Account Number.
+func IsIBAN(str string) bool {
+	_, err := ParseIBAN(str)
+	return err == nil
+}
+
+// IsBIC checks if the string is a Business Identifier Code (also known as a SWIFT code)
+// e.g. DEUTDEFF or DEUTDEFF500, with an ISO3166 alpha-2 country code.
+func IsBIC(str string) bool {
+	return rxBIC.MatchString(str) && IsISO3166Alpha2(str[4:6])
+}
+
+// IsABARouting checks if the string is an American Bankers Association routing transit number
+// i.e. 9 digits with a valid Federal Reserve prefix and checksum.
+func IsABARouting(str string) bool {
+	if len(str) != 9 || !rxABARouting.MatchString(str) {
+		return false
+	}
+	weights := [3]int{3, 7, 1}
+	sum := 0
+	for i, c := range str {
+		sum += int(c-'0') * weights[i%3]
+	}
+	return sum%10 == 0
+}
+
+// IsUKSortCode checks if the string is a UK bank sort code e.g. 12-34-56, 12 34 56 or 123456.
+func IsUKSortCode(str string) bool {
+	return rxUKSortCode.MatchString(str)
+}
@@ -0,0 +1,131 @@
+package govalidator
+
+import "testing"
+
+func TestIsIBAN(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"GB82WEST12345698765432", true},
+		{"GB82 WEST 1234 5698 7654 32", true},
+		{"gb82west12345698765432", true},
+		{"DE89370400440532013000", true},
+		{"FR1420041010050500013M02606", true},
+		{"NL91ABNA0417164300", true},
+		{"BE68539007547034", true},
+		{"NO9386011117947", true},
+		{"CH9300762011623852957", true},
+		{"GB83WEST12345698765432", false},
+		{"GB82WEST1234569876543", false},
+		{"US82WEST12345698765432", false},
+		{"GB82WEST1234569876543!", false},
+		{"GB", false},
+	}
+	for _, test := range tests {
+		actual := IsIBAN(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsIBAN(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestParseIBAN(t *testing.T) {
+	t.Parallel()
+
+	info, err := ParseIBAN("GB82 WEST 1234 5698 7654 32")
+	if err != nil {
+		t.Fatalf("Expected ParseIBAN to succeed, got %v", err)
+	}
+	expected := IBANInfo{"GB", "82", "WEST12345698765432"}
+	if *info != expected {
+		t.Errorf("Expected ParseIBAN to be %v, got %v", expected, *info)
+	}
+	if info.String() != "GB82WEST12345698765432" {
+		t.Errorf("Expected IBANInfo.String() to be GB82WEST12345698765432, got %s", info.String())
+	}
+}
+
+func TestIBANLengthsCountries(t *testing.T) {
+	t.Parallel()
+
+	for country := range IBANLengths {
+		if !IsISO3166Alpha2(country) {
+			t.Errorf("Expected IBANLengths country %s to be an ISO3166 alpha-2 code", country)
+		}
+	}
+}
+
+func TestIsBIC(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"DEUTDEFF", true},
+		{"DEUTDEFF500", true},
+		{"NWBKGB2L", true},
+		{"NWBKZZ2L", false},
+		{"deutdeff", false},
+		{"DEUTDEFF50", false},
+		{"DEU1DEFF", false},
+	}
+	for _, test := range tests {
+		actual := IsBIC(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsBIC(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestIsABARouting(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"011000015", true},
+		{"021000021", true},
+		{"121000358", true},
+		{"121000359", false},
+		{"12100035", false},
+		{"921000358", false},
+		{"12100035a", false},
+	}
+	for _, test := range tests {
+		actual := IsABARouting(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsABARouting(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestIsUKSortCode(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"12-34-56", true},
+		{"12 34 56", true},
+		{"123456", true},
+		{"12-34 56", false},
+		{"12345", false},
+		{"12-34-5a", false},
+	}
+	for _, test := range tests {
+		actual := IsUKSortCode(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsUKSortCode(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"time"
 )
 
 // ToString convert the input to a string.
@@ -58,6 +59,35 @@ func ToInt(value interface{}) (res int64, err error) {
 	return
 }
 
+// ToNumber convert the input string to an int64, a uint64 (when too large for
+// an int64) or a float64, whichever is the first to represent it exactly.
+func ToNumber(str string) (interface{}, error) {
+	if res, err := strconv.ParseInt(str, 10, 64); err == nil {
+		return res, nil
+	}
+	if res, err := strconv.ParseUint(str, 10, 64); err == nil {
+		return res, nil
+	}
+	if !IsFloat(str) {
+		return nil, fmt.Errorf("%q is not a number", str)
+	}
+	return strconv.ParseFloat(str, 64)
+}
+
+// ToTime convert the input string to a time. RFC 3339 timestamps (with or without the zone)
+// and dates such as 2006-01-02 are supported, a missing zone is treated as UTC.
+func ToTime(str string) (time.Time, error) {
+	switch {
+	case IsRFC3339(str):
+		return time.Parse(time.RFC3339, str)
+	case IsRFC3339WithoutZone(str):
+		return time.Parse(RF3339WithoutZone, str)
+	case IsTime(str, dateLayout):
+		return time.Parse(dateLayout, str)
+	}
+	return time.Time{}, fmt.Errorf("%q is not a RFC 3339 timestamp or date", str)
+}
+
 // ToBoolean convert the input string to a boolean.
 func ToBoolean(str string) (bool, error) {
 	return strconv.ParseBool(str)
@@ -17,6 +17,18 @@ func TestToInt(t *testing.T) {
 	}
 }
 
+func TestToNumber(t *testing.T) {
+	tests := []string{"1000", "-123", "18446744073709551615", "0.5", "-1e3", "abcdef", "NaN", ""}
+	expected := []interface{}{int64(1000), int64(-123), uint64(18446744073709551615), 0.5, -1000.0, nil, nil, nil}
+	for i := 0; i < len(tests); i++ {
+		result, _ := ToNumber(tests[i])
+		if result != expected[i] {
+			t.Log("Case ", i, ": expected ", expected[i], " when result is ", result)
+			t.FailNow()
+		}
+	}
+}
+
 func TestToBoolean(t *testing.T) {
 	tests := []string{"true", "1", "True", "false", "0", "abcdef"}
 	expected := []bool{true, true, true, false, false, false}
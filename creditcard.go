@@ -0,0 +1,136 @@
+package govalidator
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Card brands returned by CardBrand and accepted by the `creditcard(...)` and `cvv(...)` tags.
+const (
+	CardVisa       = "visa"
+	CardMastercard = "mastercard"
+	CardAmex       = "amex"
+	CardDiscover   = "discover"
+	CardJCB        = "jcb"
+	CardDiners     = "diners"
+	CardUnionPay   = "unionpay"
+	CardMaestro    = "maestro"
+)
+
+// cardBrandRule is the inclusive IIN (BIN) ranges and lengths of a card brand.
+type cardBrandRule struct {
+	brand   string
+	ranges  [][2]string
+	lengths []int
+}
+
+// cardBrandRules are checked in order, so more specific ranges come first.
+var cardBrandRules = []cardBrandRule{
+	{CardAmex, [][2]string{{"34", "34"}, {"37", "37"}}, []int{15}},
+	{CardDiners, [][2]string{{"300", "305"}, {"3095", "3095"}, {"36", "36"}, {"38", "39"}}, []int{14, 15, 16, 17, 18, 19}},
+	{CardJCB, [][2]string{{"3528", "3589"}}, []int{16, 17, 18, 19}},
+	{CardVisa, [][2]string{{"4", "4"}}, []int{13, 16, 19}},
+	{CardMastercard, [][2]string{{"51", "55"}, {"2221", "2720"}}, []int{16}},
+	{CardDiscover, [][2]string{{"6011", "6011"}, {"644", "649"}, {"65", "65"}}, []int{16, 17, 18, 19}},
+	{CardUnionPay, [][2]string{{"62", "62"}, {"81", "81"}}, []int{16, 17, 18, 19}},
+	{CardMaestro, [][2]string{{"50", "50"}, {"56", "58"}, {"6304", "6304"}, {"639", "639"}, {"67", "67"}}, []int{12, 13, 14, 15, 16, 17, 18, 19}},
+}
+
+// CardBrand returns the brand of a credit card number (one of the Card* constants)
+// from its leading digits and length, or an empty string if it's unknown.
+// Spaces and hyphens are ignored; the Luhn checksum is not checked, use IsCreditCard for that.
+func CardBrand(str string) string {
+	number := cardSeparators.Replace(str)
+	if number == "" || !IsNumeric(number) {
+		return ""
+	}
+	for _, rule := range cardBrandRules {
+		if !isInIntSlice(len(number), rule.lengths) {
+			continue
+		}
+		for _, r := range rule.ranges {
+			if n := len(r[0]); len(number) > n && number[:n] >= r[0] && number[:n] <= r[1] {
+				return rule.brand
+			}
+		}
+	}
+	return ""
+}
+
+// IsCreditCardBrand checks if the string is a credit card of one of the brands e.g. IsCreditCardBrand(str, CardVisa, CardMastercard).
+func IsCreditCardBrand(str string, brands ...string) bool {
+	return IsCreditCard(str) && IsIn(CardBrand(str), brands...)
+}
+
+// IsCVV checks if the string is a card verification value, which is 4 digits for Amex and 3 digits for the other
+// brands returned by CardBrand. An empty brand accepts either length, an unknown brand is invalid.
+func IsCVV(str string, brand string) bool {
+	if !rxCVV.MatchString(str) {
+		return false
+	}
+	brand = strings.ToLower(brand)
+	switch {
+	case brand == "":
+		return true
+	case brand == CardAmex:
+		return len(str) == 4
+	case isCardBrand(brand):
+		return len(str) == 3
+	}
+	return false
+}
+
+func isCardBrand(brand string) bool {
+	for _, rule := range cardBrandRules {
+		if rule.brand == brand {
+			return true
+		}
+	}
+	return false
+}
+
+// IsCardExpiry checks if the string is a card expiry date (MM/YY or MM/YYYY) that hasn't passed.
+// Cards expire at the end of their expiry month.
+func IsCardExpiry(str string) bool {
+	parts := rxCardExpiry.FindStringSubmatch(str)
+	if parts == nil {
+		return false
+	}
+	month, _ := strconv.Atoi(parts[1])
+	year, _ := strconv.Atoi(parts[2])
+	if len(parts[2]) == 2 {
+		year += 2000
+	}
+	now := time.Now()
+	return year > now.Year() || year == now.Year() && time.Month(month) >= now.Month()
+}
+
+func isCVV(str string) bool {
+	return IsCVV(str, "")
+}
+
+var cardSeparators = strings.NewReplacer(" ", "", "-", "")
+
+func isInIntSlice(n int, slice []int) bool {
+	for _, i := range slice {
+		if i == n {
+			return true
+		}
+	}
+	return false
+}
+
+func isCreditCardRaw(str string, params ...string) bool {
+	if len(params) == 1 {
+		return IsCreditCardBrand(str, strings.Split(strings.ToLower(params[0]), "|")...)
+	}
+	return false
+}
+
+func isCVVRaw(str string, params ...string) bool {
+	if len(params) == 1 {
+		return IsCVV(str, params[0])
+	}
+	return false
+}
@@ -0,0 +1,138 @@
+package govalidator
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestCardBrand(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected string
+	}{
+		{"", ""},
+		{"foo", ""},
+		{"4111111111111111", CardVisa},
+		{"4111 1111 1111 1111", CardVisa},
+		{"5555555555554444", CardMastercard},
+		{"2223003122003222", CardMastercard},
+		{"2720990000000007", CardMastercard},
+		{"2721000000000004", ""},
+		{"378282246310005", CardAmex},
+		{"3782-822463-10005", CardAmex},
+		{"6011111111111117", CardDiscover},
+		{"6441111111111117", CardDiscover},
+		{"3530111333300000", CardJCB},
+		{"30569309025904", CardDiners},
+		{"36227206271667", CardDiners},
+		{"6200000000000005", CardUnionPay},
+		{"6205500000000000004", CardUnionPay},
+		{"6759649826438453", CardMaestro},
+		{"6304000000000000", CardMaestro},
+		{"41111111111111111", ""},
+		{"9111111111111111", ""},
+	}
+	for _, test := range tests {
+		actual := CardBrand(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected CardBrand(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestIsCreditCardBrand(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		brands   []string
+		expected bool
+	}{
+		{"4111111111111111", []string{CardVisa, CardMastercard}, true},
+		{"2223003122003222", []string{CardVisa, CardMastercard}, true},
+		{"378282246310005", []string{CardVisa, CardMastercard}, false},
+		{"4111111111111112", []string{CardVisa}, false},
+	}
+	for _, test := range tests {
+		actual := IsCreditCardBrand(test.param, test.brands...)
+		if actual != test.expected {
+			t.Errorf("Expected IsCreditCardBrand(%q, %v) to be %v, got %v", test.param, test.brands, test.expected, actual)
+		}
+	}
+}
+
+func TestIsCVV(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		brand    string
+		expected bool
+	}{
+		{"", "", false},
+		{"123", "", true},
+		{"1234", "", true},
+		{"123", CardVisa, true},
+		{"1234", CardVisa, false},
+		{"1234", "Amex", true},
+		{"123", CardAmex, false},
+		{"12a", "", false},
+		{"12345", "", false},
+		{"123", CardMaestro, true},
+		{"123", "bankcard", false},
+		{"1234", "bankcard", false},
+	}
+	for _, test := range tests {
+		actual := IsCVV(test.param, test.brand)
+		if actual != test.expected {
+			t.Errorf("Expected IsCVV(%q, %q) to be %v, got %v", test.param, test.brand, test.expected, actual)
+		}
+	}
+}
+
+func TestIsCardExpiry(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	lastMonth := now.AddDate(0, 0, -now.Day())
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{now.Format("01/06"), true},
+		{now.Format("01/2006"), true},
+		{now.AddDate(1, 0, 0).Format("01/06"), true},
+		{now.AddDate(1, 0, 0).Format("01 / 2006"), true},
+		{lastMonth.Format("01/06"), false},
+		{lastMonth.Format("01/2006"), false},
+		{fmt.Sprintf("13/%d", now.Year()+1), false},
+		{fmt.Sprintf("1/%d", now.Year()+1), false},
+		{"12-99", false},
+	}
+	for _, test := range tests {
+		actual := IsCardExpiry(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsCardExpiry(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestValidateCreditCardBrand(t *testing.T) {
+	type Payment struct {
+		Card   string `valid:"creditcard(visa|mastercard)"`
+		CVV    string `valid:"cvv"`
+		Expiry string `valid:"cardexpiry"`
+	}
+
+	expiry := time.Now().AddDate(1, 0, 0).Format("01/06")
+	if ok, err := Validate(Payment{"2223003122003222", "123", expiry}); !ok {
+		t.Errorf("Expected payment to pass, got %v", err)
+	}
+	if ok, err := Validate(Payment{"378282246310005", "12", "01/00"}); ok {
+		t.Errorf("Expected payment to fail, got %v", err)
+	}
+}
@@ -0,0 +1,458 @@
+package govalidator
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// KeyRequirements are the requirements on the keys (and certificates) checked by the crypto validators.
+// The zero value accepts any RSA, ECDSA or Ed25519 key.
+type KeyRequirements struct {
+	Types      []string // allowed key types: rsa, ecdsa or ed25519, empty for any
+	Curves     []string // allowed curves of ECDSA keys: P-224, P-256, P-384 or P-521, empty for any (only ECDSA keys without Types)
+	MinBits    int      // min RSA modulus or ECDSA curve size, Ed25519 keys have 256 bits
+	NotExpired bool     // certificates must be valid at the time of validation
+}
+
+// ParseKeyRequirements parses the options of the crypto tags e.g. `privkey(ecdsa|P-256)` or `cert(notexpired|2048)`.
+// An option is a key type, a curve, a min number of bits or notexpired.
+func ParseKeyRequirements(options ...string) (KeyRequirements, error) {
+	var r KeyRequirements
+	for _, option := range options {
+		switch {
+		case option == "notexpired":
+			r.NotExpired = true
+		case option == "rsa" || option == "ecdsa" || option == "ed25519":
+			r.Types = append(r.Types, option)
+		case keyCurves[option]:
+			r.Curves = append(r.Curves, option)
+		case IsInt(option) && option != "":
+			bits, err := ToInt(option)
+			if err != nil || bits <= 0 {
+				return r, fmt.Errorf("invalid key size %s", option)
+			}
+			r.MinBits = int(bits)
+		default:
+			return r, fmt.Errorf("unknown key option %s", option)
+		}
+	}
+	return r, nil
+}
+
+// keyCurves are the ECDSA curves of the crypto validators.
+var keyCurves = map[string]bool{"P-224": true, "P-256": true, "P-384": true, "P-521": true}
+
+// keyInfo describes a public key.
+type keyInfo struct {
+	typ   string
+	curve string
+	bits  int
+}
+
+func publicKeyInfo(pub crypto.PublicKey) (keyInfo, error) {
+	switch key := pub.(type) {
+	case *rsa.PublicKey:
+		return keyInfo{"rsa", "", key.N.BitLen()}, nil
+	case *ecdsa.PublicKey:
+		params := key.Curve.Params()
+		return keyInfo{"ecdsa", params.Name, params.BitSize}, nil
+	case ed25519.PublicKey:
+		return keyInfo{"ed25519", "", 256}, nil
+	}
+	return keyInfo{}, fmt.Errorf("unsupported key type %T", pub)
+}
+
+func (r KeyRequirements) checkKey(key keyInfo) error {
+	if len(r.Types) > 0 && !containsString(r.Types, key.typ) || len(r.Types) == 0 && len(r.Curves) > 0 && key.typ != "ecdsa" {
+		return fmt.Errorf("key type %s is not allowed", key.typ)
+	}
+	if len(r.Curves) > 0 && key.typ == "ecdsa" && !containsString(r.Curves, key.curve) {
+		return fmt.Errorf("curve %s is not allowed", key.curve)
+	}
+	if key.bits < r.MinBits {
+		return fmt.Errorf("key must be at least %d bits, got %d", r.MinBits, key.bits)
+	}
+	return nil
+}
+
+func (r KeyRequirements) checkPublicKey(pub crypto.PublicKey) error {
+	key, err := publicKeyInfo(pub)
+	if err != nil {
+		return err
+	}
+	return r.checkKey(key)
+}
+
+func containsString(list []string, str string) bool {
+	for _, s := range list {
+		if s == str {
+			return true
+		}
+	}
+	return false
+}
+
+// decodePEM decodes all the PEM blocks of a string, which must not contain anything else but whitespace.
+func decodePEM(str string) ([]*pem.Block, error) {
+	var blocks []*pem.Block
+	rest := []byte(str)
+	for {
+		// pem.Decode skips any text before a block.
+		rest = bytes.TrimLeft(rest, " \t\r\n")
+		if len(rest) > 0 && !bytes.HasPrefix(rest, []byte("-----BEGIN")) {
+			if len(blocks) == 0 {
+				return nil, errors.New("unexpected data before the PEM blocks")
+			}
+			return nil, errors.New("unexpected data after the PEM blocks")
+		}
+		block, next := pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		blocks = append(blocks, block)
+		rest = next
+	}
+	if len(blocks) == 0 {
+		return nil, errors.New("no PEM data found")
+	}
+	if strings.TrimSpace(string(rest)) != "" {
+		return nil, errors.New("unexpected data after the PEM blocks")
+	}
+	return blocks, nil
+}
+
+func (r KeyRequirements) checkCertificate(cert *x509.Certificate, now time.Time) error {
+	if r.NotExpired {
+		if now.Before(cert.NotBefore) {
+			return fmt.Errorf("certificate is not valid before %s", cert.NotBefore.Format(time.RFC3339))
+		}
+		if now.After(cert.NotAfter) {
+			return fmt.Errorf("certificate expired on %s", cert.NotAfter.Format(time.RFC3339))
+		}
+	}
+	return r.checkPublicKey(cert.PublicKey)
+}
+
+func parseCertificates(str string) ([]*x509.Certificate, error) {
+	blocks, err := decodePEM(str)
+	if err != nil {
+		return nil, err
+	}
+	certs := make([]*x509.Certificate, len(blocks))
+	for i, block := range blocks {
+		if block.Type != "CERTIFICATE" {
+			return nil, fmt.Errorf("unexpected PEM block %s, want CERTIFICATE", block.Type)
+		}
+		if certs[i], err = x509.ParseCertificate(block.Bytes); err != nil {
+			return nil, fmt.Errorf("invalid certificate: %v", err)
+		}
+	}
+	return certs, nil
+}
+
+// ValidateCertificate checks the string is a single PEM encoded x509 certificate whose key meets the requirements.
+func (r KeyRequirements) ValidateCertificate(str string) error {
+	certs, err := parseCertificates(str)
+	if err != nil {
+		return err
+	}
+	if len(certs) != 1 {
+		return fmt.Errorf("expected a single certificate, got %d", len(certs))
+	}
+	return r.checkCertificate(certs[0], time.Now())
+}
+
+// ValidateCertificateChain checks the string is a chain of PEM encoded x509 certificates, leaf first, where each
+// certificate is signed by the next one. Every certificate must meet the requirements. The chain isn't verified
+// against any root certificates.
+func (r KeyRequirements) ValidateCertificateChain(str string) error {
+	certs, err := parseCertificates(str)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for i, cert := range certs {
+		if err := r.checkCertificate(cert, now); err != nil {
+			return fmt.Errorf("certificate %d: %v", i+1, err)
+		}
+		if i+1 < len(certs) {
+			if err := cert.CheckSignatureFrom(certs[i+1]); err != nil {
+				return fmt.Errorf("certificate %d is not signed by certificate %d: %v", i+1, i+2, err)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidatePrivateKey checks the string is a PEM encoded PKCS#8, PKCS#1 (RSA) or SEC 1 (EC) private key that meets
+// the requirements. Encrypted private keys are rejected.
+func (r KeyRequirements) ValidatePrivateKey(str string) error {
+	blocks, err := decodePEM(str)
+	if err != nil {
+		return err
+	}
+	if len(blocks) != 1 {
+		return fmt.Errorf("expected a single private key, got %d PEM blocks", len(blocks))
+	}
+	block := blocks[0]
+	if block.Headers["Proc-Type"] != "" || block.Type == "ENCRYPTED PRIVATE KEY" {
+		return errors.New("encrypted private keys are not supported")
+	}
+	var key interface{}
+	switch block.Type {
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		return fmt.Errorf("unexpected PEM block %s, want PRIVATE KEY", block.Type)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid private key: %v", err)
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return fmt.Errorf("unsupported key type %T", key)
+	}
+	return r.checkPublicKey(signer.Public())
+}
+
+// ValidatePublicKey checks the string is a PEM encoded PKIX or PKCS#1 (RSA) public key that meets the requirements.
+func (r KeyRequirements) ValidatePublicKey(str string) error {
+	blocks, err := decodePEM(str)
+	if err != nil {
+		return err
+	}
+	if len(blocks) != 1 {
+		return fmt.Errorf("expected a single public key, got %d PEM blocks", len(blocks))
+	}
+	var key interface{}
+	switch block := blocks[0]; block.Type {
+	case "PUBLIC KEY":
+		key, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		key, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		return fmt.Errorf("unexpected PEM block %s, want PUBLIC KEY", block.Type)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid public key: %v", err)
+	}
+	return r.checkPublicKey(key)
+}
+
+// sshECDSAKeys maps the OpenSSH ECDSA key types to their curves.
+var sshECDSAKeys = map[string]struct {
+	name  string
+	curve elliptic.Curve
+	bits  int
+}{
+	"ecdsa-sha2-nistp256": {"P-256", elliptic.P256(), 256},
+	"ecdsa-sha2-nistp384": {"P-384", elliptic.P384(), 384},
+	"ecdsa-sha2-nistp521": {"P-521", elliptic.P521(), 521},
+}
+
+func isSSHKeyType(typ string) bool {
+	_, ecdsa := sshECDSAKeys[typ]
+	return typ == "ssh-rsa" || typ == "ssh-ed25519" || ecdsa
+}
+
+// ValidateAuthorizedKey checks the string is an OpenSSH authorized_keys line e.g. "ssh-ed25519 AAAAC3Nza... user@host"
+// with optional leading options e.g. `from="10.0.0.0/8",no-pty`, whose key meets the requirements.
+// Supported key types are ssh-rsa, ssh-ed25519 and ecdsa-sha2-nistp256/384/521.
+func (r KeyRequirements) ValidateAuthorizedKey(str string) error {
+	line := strings.TrimSpace(str)
+	if line == "" || line[0] == '#' || strings.ContainsAny(line, "\r\n") {
+		return errors.New("expected a single authorized_keys line")
+	}
+	// Key blobs start with the length of the key type, "AAAA" in base64, so a line whose first field isn't a key type
+	// and isn't followed by a key blob starts with options.
+	if fields := strings.Fields(line); len(fields) > 1 && !isSSHKeyType(fields[0]) && !strings.HasPrefix(fields[1], "AAAA") {
+		line = strings.TrimLeft(line[sshOptionsEnd(line):], " \t")
+	}
+	fields := strings.Fields(line)
+	if len(fields) < 2 {
+		return errors.New("missing SSH key type or key")
+	}
+	if !isSSHKeyType(fields[0]) {
+		return fmt.Errorf("unsupported SSH key type %s", fields[0])
+	}
+	blob, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return errors.New("SSH key is not valid base64")
+	}
+	key, err := parseSSHPublicKey(fields[0], blob)
+	if err != nil {
+		return err
+	}
+	return r.checkKey(key)
+}
+
+// sshOptionsEnd returns the index of the first whitespace outside of double quotes, which ends the options.
+func sshOptionsEnd(line string) int {
+	quoted := false
+	for i := 0; i < len(line); i++ {
+		switch c := line[i]; {
+		case c == '\\' && quoted && i+1 < len(line):
+			i++
+		case c == '"':
+			quoted = !quoted
+		case (c == ' ' || c == '\t') && !quoted:
+			return i
+		}
+	}
+	return len(line)
+}
+
+// parseSSHPublicKey parses the wire format (RFC 4253) of an OpenSSH public key.
+func parseSSHPublicKey(typ string, blob []byte) (keyInfo, error) {
+	invalid := fmt.Errorf("invalid %s key", typ)
+	name, blob, ok := readSSHString(blob)
+	if !ok || string(name) != typ {
+		return keyInfo{}, invalid
+	}
+	var key keyInfo
+	switch typ {
+	case "ssh-rsa":
+		e, rest, ok := readSSHString(blob)
+		n, rest, ok2 := readSSHString(rest)
+		if !ok || !ok2 || len(e) == 0 || len(n) == 0 || e[0]&0x80 != 0 || n[0]&0x80 != 0 {
+			return keyInfo{}, invalid
+		}
+		key, blob = keyInfo{"rsa", "", new(big.Int).SetBytes(n).BitLen()}, rest
+	case "ssh-ed25519":
+		pub, rest, ok := readSSHString(blob)
+		if !ok || len(pub) != ed25519.PublicKeySize {
+			return keyInfo{}, invalid
+		}
+		key, blob = keyInfo{"ed25519", "", 256}, rest
+	default:
+		ec := sshECDSAKeys[typ]
+		id, rest, ok := readSSHString(blob)
+		point, rest, ok2 := readSSHString(rest)
+		if !ok || !ok2 || string(id) != strings.TrimPrefix(typ, "ecdsa-sha2-") {
+			return keyInfo{}, invalid
+		}
+		if x, _ := elliptic.Unmarshal(ec.curve, point); x == nil {
+			return keyInfo{}, invalid
+		}
+		key, blob = keyInfo{"ecdsa", ec.name, ec.bits}, rest
+	}
+	if len(blob) != 0 {
+		return keyInfo{}, invalid
+	}
+	return key, nil
+}
+
+func readSSHString(blob []byte) (value, rest []byte, ok bool) {
+	if len(blob) < 4 {
+		return nil, nil, false
+	}
+	n := binary.BigEndian.Uint32(blob)
+	if uint64(n) > uint64(len(blob)-4) {
+		return nil, nil, false
+	}
+	return blob[4 : 4+n], blob[4+n:], true
+}
+
+// IsCertificate checks if the string is a single PEM encoded x509 certificate with an RSA, ECDSA or Ed25519 key.
+func IsCertificate(str string) bool {
+	return KeyRequirements{}.ValidateCertificate(str) == nil
+}
+
+// IsCertificateChain checks if the string is a chain of PEM encoded x509 certificates, each signed by the next one.
+func IsCertificateChain(str string) bool {
+	return KeyRequirements{}.ValidateCertificateChain(str) == nil
+}
+
+// IsPrivateKey checks if the string is an unencrypted PEM encoded RSA, ECDSA or Ed25519 private key.
+func IsPrivateKey(str string) bool {
+	return KeyRequirements{}.ValidatePrivateKey(str) == nil
+}
+
+// IsPublicKey checks if the string is a PEM encoded RSA, ECDSA or Ed25519 public key.
+func IsPublicKey(str string) bool {
+	return KeyRequirements{}.ValidatePublicKey(str) == nil
+}
+
+// IsECPublicKey checks if the string is a PEM encoded ECDSA public key on one of the curves e.g. P-256, or any curve if none are given.
+func IsECPublicKey(str string, curves ...string) bool {
+	return KeyRequirements{Types: []string{"ecdsa"}, Curves: curves}.ValidatePublicKey(str) == nil
+}
+
+// IsAuthorizedKey checks if the string is an OpenSSH authorized_keys line.
+func IsAuthorizedKey(str string) bool {
+	return KeyRequirements{}.ValidateAuthorizedKey(str) == nil
+}
+
+// keyRequirementsRaw parses the optional `|` separated options of a crypto tag.
+func keyRequirementsRaw(params []string) (KeyRequirements, error) {
+	if len(params) == 0 || params[0] == "" {
+		return KeyRequirements{}, nil
+	}
+	return ParseKeyRequirements(strings.Split(params[0], "|")...)
+}
+
+func validateCertificateRaw(str string, params ...string) error {
+	r, err := keyRequirementsRaw(params)
+	if err != nil {
+		return err
+	}
+	return r.ValidateCertificate(str)
+}
+
+func validateCertificateChainRaw(str string, params ...string) error {
+	r, err := keyRequirementsRaw(params)
+	if err != nil {
+		return err
+	}
+	return r.ValidateCertificateChain(str)
+}
+
+func validatePrivateKeyRaw(str string, params ...string) error {
+	r, err := keyRequirementsRaw(params)
+	if err != nil {
+		return err
+	}
+	return r.ValidatePrivateKey(str)
+}
+
+func validatePublicKeyRaw(str string, params ...string) error {
+	r, err := keyRequirementsRaw(params)
+	if err != nil {
+		return err
+	}
+	return r.ValidatePublicKey(str)
+}
+
+func validateECPublicKeyRaw(str string, params ...string) error {
+	r, err := keyRequirementsRaw(params)
+	if err != nil {
+		return err
+	}
+	r.Types = []string{"ecdsa"}
+	return r.ValidatePublicKey(str)
+}
+
+func validateAuthorizedKeyRaw(str string, params ...string) error {
+	r, err := keyRequirementsRaw(params)
+	if err != nil {
+		return err
+	}
+	return r.ValidateAuthorizedKey(str)
+}
@@ -0,0 +1,345 @@
+package govalidator
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type testKeys struct {
+	rsa2048 *rsa.PrivateKey
+	p256    *ecdsa.PrivateKey
+	p384    *ecdsa.PrivateKey
+	ed      ed25519.PrivateKey
+}
+
+var (
+	testKeysOnce sync.Once
+	testKeySet   testKeys
+)
+
+// generateTestKeys generates the keys of the crypto tests once, as RSA key generation is slow.
+func generateTestKeys(t *testing.T) testKeys {
+	testKeysOnce.Do(func() {
+		var err error
+		if testKeySet.rsa2048, err = rsa.GenerateKey(rand.Reader, 2048); err != nil {
+			t.Fatal(err)
+		}
+		testKeySet.p256, _ = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		testKeySet.p384, _ = ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+		_, testKeySet.ed, _ = ed25519.GenerateKey(rand.Reader)
+	})
+	return testKeySet
+}
+
+func encodePEM(typ string, der []byte) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der}))
+}
+
+// createTestCertificate creates a PEM certificate for the key, signed by the parent (or self-signed if nil).
+func createTestCertificate(t *testing.T, key crypto.Signer, parent *x509.Certificate, parentKey crypto.Signer, notBefore, notAfter time.Time) (string, *x509.Certificate) {
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: "govalidator test"},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, key.Public(), parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	return encodePEM("CERTIFICATE", der), cert
+}
+
+func TestParseKeyRequirements(t *testing.T) {
+	t.Parallel()
+
+	r, err := ParseKeyRequirements("ecdsa", "P-256", "P-384", "256", "notexpired")
+	if err != nil || !r.NotExpired || r.MinBits != 256 || len(r.Types) != 1 || len(r.Curves) != 2 {
+		t.Errorf("Expected ParseKeyRequirements to parse all options, got %+v, %v", r, err)
+	}
+	for _, option := range []string{"dsa", "P-192", "-1", "0", ""} {
+		if _, err := ParseKeyRequirements(option); err == nil {
+			t.Errorf("Expected ParseKeyRequirements(%q) to fail", option)
+		}
+	}
+}
+
+func TestValidateCertificate(t *testing.T) {
+	t.Parallel()
+
+	keys := generateTestKeys(t)
+	now := time.Now()
+	valid, _ := createTestCertificate(t, keys.rsa2048, nil, nil, now.Add(-time.Hour), now.Add(time.Hour))
+	expired, _ := createTestCertificate(t, keys.p256, nil, nil, now.Add(-2*time.Hour), now.Add(-time.Hour))
+	future, _ := createTestCertificate(t, keys.ed, nil, nil, now.Add(time.Hour), now.Add(2*time.Hour))
+
+	var tests = []struct {
+		param    string
+		options  []string
+		expected string
+	}{
+		{valid, nil, ""},
+		{valid, []string{"notexpired", "2048"}, ""},
+		{valid, []string{"3072"}, "key must be at least 3072 bits, got 2048"},
+		{valid, []string{"ecdsa"}, "key type rsa is not allowed"},
+		{expired, nil, ""},
+		{expired, []string{"notexpired"}, "certificate expired on"},
+		{expired, []string{"P-384"}, "curve P-256 is not allowed"},
+		{future, []string{"notexpired"}, "certificate is not valid before"},
+		{future, []string{"ed25519"}, ""},
+		{valid + expired, nil, "expected a single certificate, got 2"},
+		{valid + "garbage", nil, "unexpected data after the PEM blocks"},
+		{"rm -rf / ; garbage\n" + valid, nil, "unexpected data before the PEM blocks"},
+		{valid + "garbage\n" + valid, nil, "unexpected data after the PEM blocks"},
+		{"\n  " + valid + "\n", nil, ""},
+		{encodePEM("CERTIFICATE", []byte("not a certificate")), nil, "invalid certificate"},
+		{encodePEM("PUBLIC KEY", []byte{}), nil, "unexpected PEM block PUBLIC KEY, want CERTIFICATE"},
+		{"", nil, "no PEM data found"},
+	}
+	for _, test := range tests {
+		r, _ := ParseKeyRequirements(test.options...)
+		err := r.ValidateCertificate(test.param)
+		if test.expected == "" && err != nil || test.expected != "" && (err == nil || !strings.Contains(err.Error(), test.expected)) {
+			t.Errorf("Expected ValidateCertificate(%v) to be %q, got %v", test.options, test.expected, err)
+		}
+	}
+	if !IsCertificate(valid) || IsCertificate(valid+expired) {
+		t.Error("Expected IsCertificate to accept a single certificate only")
+	}
+}
+
+func TestValidateCertificateChain(t *testing.T) {
+	t.Parallel()
+
+	keys := generateTestKeys(t)
+	now := time.Now()
+	rootPEM, root := createTestCertificate(t, keys.p384, nil, nil, now.Add(-time.Hour), now.Add(time.Hour))
+	intermediatePEM, intermediate := createTestCertificate(t, keys.p256, root, keys.p384, now.Add(-time.Hour), now.Add(time.Hour))
+	leafPEM, _ := createTestCertificate(t, keys.ed, intermediate, keys.p256, now.Add(-time.Hour), now.Add(time.Hour))
+	expiredPEM, _ := createTestCertificate(t, keys.ed, intermediate, keys.p256, now.Add(-2*time.Hour), now.Add(-time.Hour))
+
+	var tests = []struct {
+		param    string
+		options  []string
+		expected string
+	}{
+		{leafPEM + intermediatePEM + rootPEM, nil, ""},
+		{leafPEM + intermediatePEM, []string{"notexpired"}, ""},
+		{leafPEM, nil, ""},
+		{leafPEM + rootPEM, nil, "certificate 1 is not signed by certificate 2"},
+		{intermediatePEM + leafPEM, nil, "certificate 1 is not signed by certificate 2"},
+		{expiredPEM + intermediatePEM, []string{"notexpired"}, "certificate 1: certificate expired on"},
+		{leafPEM + intermediatePEM + rootPEM, []string{"ecdsa", "ed25519", "P-256"}, "certificate 3: curve P-384 is not allowed"},
+	}
+	for _, test := range tests {
+		r, _ := ParseKeyRequirements(test.options...)
+		err := r.ValidateCertificateChain(test.param)
+		if test.expected == "" && err != nil || test.expected != "" && (err == nil || !strings.Contains(err.Error(), test.expected)) {
+			t.Errorf("Expected ValidateCertificateChain(%v) to be %q, got %v", test.options, test.expected, err)
+		}
+	}
+	if !IsCertificateChain(leafPEM + intermediatePEM) {
+		t.Error("Expected IsCertificateChain to accept a signed chain")
+	}
+}
+
+func TestValidatePrivateKey(t *testing.T) {
+	t.Parallel()
+
+	keys := generateTestKeys(t)
+	pkcs8 := func(key interface{}) string {
+		der, err := x509.MarshalPKCS8PrivateKey(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return encodePEM("PRIVATE KEY", der)
+	}
+	sec1, _ := x509.MarshalECPrivateKey(keys.p384)
+	encrypted := string(pem.EncodeToMemory(&pem.Block{
+		Type:    "RSA PRIVATE KEY",
+		Headers: map[string]string{"Proc-Type": "4,ENCRYPTED", "DEK-Info": "AES-256-CBC,00000000000000000000000000000000"},
+		Bytes:   []byte("encrypted"),
+	}))
+
+	var tests = []struct {
+		param    string
+		options  []string
+		expected string
+	}{
+		{pkcs8(keys.rsa2048), []string{"2048"}, ""},
+		{pkcs8(keys.p256), []string{"P-256"}, ""},
+		{pkcs8(keys.ed), []string{"ed25519"}, ""},
+		{pkcs8(keys.ed), []string{"P-256"}, "key type ed25519 is not allowed"},
+		{pkcs8(keys.rsa2048), []string{"P-256"}, "key type rsa is not allowed"},
+		{pkcs8(keys.ed), []string{"ed25519", "P-256"}, ""},
+		{pkcs8(keys.ed), []string{"ecdsa", "P-256"}, "key type ed25519 is not allowed"},
+		{encodePEM("RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(keys.rsa2048)), []string{"rsa"}, ""},
+		{encodePEM("RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(keys.rsa2048)), []string{"4096"}, "key must be at least 4096 bits, got 2048"},
+		{encodePEM("EC PRIVATE KEY", sec1), []string{"P-384", "384"}, ""},
+		{encodePEM("EC PRIVATE KEY", sec1), []string{"P-256"}, "curve P-384 is not allowed"},
+		{encodePEM("EC PRIVATE KEY", []byte("junk")), nil, "invalid private key"},
+		{encrypted, nil, "encrypted private keys are not supported"},
+		{encodePEM("ENCRYPTED PRIVATE KEY", []byte("junk")), nil, "encrypted private keys are not supported"},
+		{pkcs8(keys.ed) + pkcs8(keys.p256), nil, "expected a single private key, got 2 PEM blocks"},
+		{encodePEM("CERTIFICATE", []byte("junk")), nil, "unexpected PEM block CERTIFICATE, want PRIVATE KEY"},
+		{"rm -rf / ; garbage\n" + pkcs8(keys.ed), nil, "unexpected data before the PEM blocks"},
+	}
+	for _, test := range tests {
+		r, _ := ParseKeyRequirements(test.options...)
+		err := r.ValidatePrivateKey(test.param)
+		if test.expected == "" && err != nil || test.expected != "" && (err == nil || !strings.Contains(err.Error(), test.expected)) {
+			t.Errorf("Expected ValidatePrivateKey(%v) to be %q, got %v", test.options, test.expected, err)
+		}
+	}
+	if !IsPrivateKey(pkcs8(keys.ed)) || IsPrivateKey("") {
+		t.Error("Expected IsPrivateKey to accept PKCS#8 keys only")
+	}
+}
+
+func TestValidatePublicKey(t *testing.T) {
+	t.Parallel()
+
+	keys := generateTestKeys(t)
+	pkix := func(key crypto.PublicKey) string {
+		der, err := x509.MarshalPKIXPublicKey(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return encodePEM("PUBLIC KEY", der)
+	}
+
+	var tests = []struct {
+		param    string
+		curves   []string
+		expected bool
+	}{
+		{pkix(keys.p256.Public()), nil, true},
+		{pkix(keys.p256.Public()), []string{"P-256"}, true},
+		{pkix(keys.p384.Public()), []string{"P-256"}, false},
+		{pkix(keys.p384.Public()), []string{"P-256", "P-384"}, true},
+		{pkix(keys.ed.Public()), nil, false},
+		{pkix(keys.rsa2048.Public()), nil, false},
+		{"", nil, false},
+	}
+	for _, test := range tests {
+		actual := IsECPublicKey(test.param, test.curves...)
+		if actual != test.expected {
+			t.Errorf("Expected IsECPublicKey(%v) to be %v, got %v", test.curves, test.expected, actual)
+		}
+	}
+	if !IsPublicKey(pkix(keys.ed.Public())) || !IsPublicKey(encodePEM("RSA PUBLIC KEY", x509.MarshalPKCS1PublicKey(&keys.rsa2048.PublicKey))) {
+		t.Error("Expected IsPublicKey to accept PKIX and PKCS#1 keys")
+	}
+}
+
+// sshWire encodes the strings in the SSH wire format.
+func sshWire(values ...[]byte) string {
+	var blob []byte
+	for _, v := range values {
+		blob = binary.BigEndian.AppendUint32(blob, uint32(len(v)))
+		blob = append(blob, v...)
+	}
+	return base64.StdEncoding.EncodeToString(blob)
+}
+
+func TestValidateAuthorizedKey(t *testing.T) {
+	t.Parallel()
+
+	keys := generateTestKeys(t)
+	n := keys.rsa2048.N.Bytes()
+	rsaKey := "ssh-rsa " + sshWire([]byte("ssh-rsa"), []byte{1, 0, 1}, append([]byte{0}, n...))
+	edKey := "ssh-ed25519 " + sshWire([]byte("ssh-ed25519"), keys.ed.Public().(ed25519.PublicKey))
+	point := elliptic.Marshal(elliptic.P256(), keys.p256.X, keys.p256.Y)
+	offCurve := append([]byte{}, point...)
+	offCurve[len(offCurve)-1] ^= 1
+	ecKey := "ecdsa-sha2-nistp256 " + sshWire([]byte("ecdsa-sha2-nistp256"), []byte("nistp256"), point)
+
+	var tests = []struct {
+		param    string
+		options  []string
+		expected string
+	}{
+		{rsaKey + " user@host", []string{"2048"}, ""},
+		{rsaKey, []string{"3072"}, "key must be at least 3072 bits, got 2048"},
+		{edKey + " deploy key", []string{"ed25519"}, ""},
+		{ecKey, []string{"P-256"}, ""},
+		{ecKey, []string{"rsa"}, "key type ecdsa is not allowed"},
+		{`from="10.0.0.0/8",command="echo \"hi there\"",no-pty ` + edKey + " ci", nil, ""},
+		{`no-pty   ` + edKey, nil, ""},
+		{"ssh-dss " + sshWire([]byte("ssh-dss")), nil, "unsupported SSH key type ssh-dss"},
+		{"ssh-ed25519 " + sshWire([]byte("ssh-ed25519"), []byte("short")), nil, "invalid ssh-ed25519 key"},
+		{"ssh-ed25519 " + sshWire([]byte("ssh-rsa"), keys.ed.Public().(ed25519.PublicKey)), nil, "invalid ssh-ed25519 key"},
+		{"ecdsa-sha2-nistp256 " + sshWire([]byte("ecdsa-sha2-nistp256"), []byte("nistp256"), []byte{4, 1, 2}), nil, "invalid ecdsa-sha2-nistp256 key"},
+		{"ecdsa-sha2-nistp384 " + sshWire([]byte("ecdsa-sha2-nistp384"), []byte("nistp256"), point), nil, "invalid ecdsa-sha2-nistp384 key"},
+		{"ecdsa-sha2-nistp256 " + sshWire([]byte("ecdsa-sha2-nistp256"), []byte("nistp256"), offCurve), nil, "invalid ecdsa-sha2-nistp256 key"},
+		{edKey + "\n" + edKey, nil, "expected a single authorized_keys line"},
+		{"ssh-ed25519 !!!", nil, "SSH key is not valid base64"},
+		{"ssh-ed25519", nil, "missing SSH key type or key"},
+		{"# " + edKey, nil, "expected a single authorized_keys line"},
+	}
+	for _, test := range tests {
+		r, _ := ParseKeyRequirements(test.options...)
+		err := r.ValidateAuthorizedKey(test.param)
+		if test.expected == "" && err != nil || test.expected != "" && (err == nil || !strings.Contains(err.Error(), test.expected)) {
+			t.Errorf("Expected ValidateAuthorizedKey(%q, %v) to be %q, got %v", test.param, test.options, test.expected, err)
+		}
+	}
+	if !IsAuthorizedKey(edKey) {
+		t.Error("Expected IsAuthorizedKey to accept an ed25519 key")
+	}
+}
+
+func TestValidateCrypto(t *testing.T) {
+	keys := generateTestKeys(t)
+	now := time.Now()
+	cert, _ := createTestCertificate(t, keys.p256, nil, nil, now.Add(-time.Hour), now.Add(time.Hour))
+	expired, _ := createTestCertificate(t, keys.p256, nil, nil, now.Add(-2*time.Hour), now.Add(-time.Hour))
+	der, _ := x509.MarshalPKCS8PrivateKey(keys.ed)
+	pub, _ := x509.MarshalPKIXPublicKey(keys.p384.Public())
+
+	type Secret struct {
+		Certificate string `json:"certificate" valid:"cert(notexpired)"`
+		Chain       string `valid:"certchain"`
+		PrivateKey  string `valid:"privkey(ed25519)"`
+		PublicKey   string `valid:"ecpub(P-256|P-384)"`
+		SSHKey      string `valid:"sshkey(2048)"`
+	}
+	rsaKey := "ssh-rsa " + sshWire([]byte("ssh-rsa"), []byte{1, 0, 1}, append([]byte{0}, keys.rsa2048.N.Bytes()...))
+	valid := Secret{cert, cert, encodePEM("PRIVATE KEY", der), encodePEM("PUBLIC KEY", pub), rsaKey}
+	if ok, err := Validate(valid); !ok {
+		t.Errorf("Expected secret to pass, got %v", err)
+	}
+
+	invalid := valid
+	invalid.Certificate = expired
+	ok, errs := Validate(invalid)
+	if actual := errs["errors"]["certificate"]; ok || len(actual) != 1 || !strings.HasPrefix(actual[0], "certificate expired on") {
+		t.Errorf("Expected secret with an expired certificate to fail with the reason, got %v", errs)
+	}
+	invalid = valid
+	invalid.PublicKey = encodePEM("PUBLIC KEY", der)
+	if ok, _ := Validate(invalid); ok {
+		t.Error("Expected secret with an invalid public key to fail")
+	}
+}
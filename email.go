@@ -0,0 +1,362 @@
+package govalidator
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/netip"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Resolver looks up the DNS records needed to tell whether an email's domain
+// exists. *net.Resolver satisfies it, tests can use a fake instead.
+type Resolver interface {
+	LookupMX(ctx context.Context, name string) ([]*net.MX, error)
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// EmailVerifier checks emails are of an existing domain using its Resolver.
+// The result of each domain lookup is cached for TTL (zero disables caching),
+// keeping up to MaxCacheSize domains (zero means no limit) by evicting the
+// oldest, and each lookup is given up after Timeout (zero means no timeout).
+// Register your own instance as a tag with:
+//     govalidator.TagMap["existingemail"] = verifier.IsExistingEmail
+type EmailVerifier struct {
+	Resolver Resolver
+	Timeout  time.Duration
+	TTL      time.Duration
+
+	MaxCacheSize int
+
+	mu    sync.RWMutex
+	cache map[string]emailDomain
+}
+
+type emailDomain struct {
+	exists  bool
+	expires time.Time
+}
+
+// DefaultEmailVerifier is used by IsExistingEmail and the `existingemail` tag.
+// Change its Resolver, Timeout or TTL before validating if required.
+var DefaultEmailVerifier = NewEmailVerifier(net.DefaultResolver, 5*time.Second, 10*time.Minute)
+
+// DefaultEmailCacheSize is the MaxCacheSize of the verifiers returned by NewEmailVerifier.
+const DefaultEmailCacheSize = 10000
+
+// NewEmailVerifier returns an EmailVerifier using resolver for its DNS lookups.
+func NewEmailVerifier(resolver Resolver, timeout, ttl time.Duration) *EmailVerifier {
+	return &EmailVerifier{
+		Resolver:     resolver,
+		Timeout:      timeout,
+		TTL:          ttl,
+		MaxCacheSize: DefaultEmailCacheSize,
+		cache:        make(map[string]emailDomain),
+	}
+}
+
+// IsExistingEmail checks if the string is an email of existing domain.
+func (ev *EmailVerifier) IsExistingEmail(email string) bool {
+	return ev.IsExistingEmailContext(context.Background(), email)
+}
+
+// IsExistingEmailContext checks if the string is an email of existing domain.
+// The DNS lookups are cancelled when ctx is done.
+func (ev *EmailVerifier) IsExistingEmailContext(ctx context.Context, email string) bool {
+	if len(email) < 6 || len(email) > 254 {
+		return false
+	}
+
+	at := strings.LastIndex(email, "@")
+	if at <= 0 || at > len(email)-3 {
+		return false
+	}
+
+	user := email[:at]
+	host := strings.ToLower(email[at+1:])
+	if len(user) > 64 {
+		return false
+	}
+
+	if userDotRegexp.MatchString(user) || !userRegexp.MatchString(user) || !hostRegexp.MatchString(host) {
+		return false
+	}
+
+	switch host {
+	case "localhost", "example.com":
+		return true
+	}
+
+	return ev.domainExists(ctx, host)
+}
+
+// ClearCache removes all of the cached domain results.
+func (ev *EmailVerifier) ClearCache() {
+	ev.mu.Lock()
+	defer ev.mu.Unlock()
+	ev.cache = make(map[string]emailDomain)
+}
+
+func (ev *EmailVerifier) domainExists(ctx context.Context, host string) bool {
+	ev.mu.RLock()
+	cached, ok := ev.cache[host]
+	ev.mu.RUnlock()
+	if ok {
+		if time.Now().Before(cached.expires) {
+			return cached.exists
+		}
+		ev.mu.Lock()
+		if ev.cache[host] == cached {
+			delete(ev.cache, host)
+		}
+		ev.mu.Unlock()
+	}
+
+	if ev.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, ev.Timeout)
+		defer cancel()
+	}
+
+	exists := true
+	if _, err := ev.Resolver.LookupMX(ctx, host); err != nil {
+		if _, err := ev.Resolver.LookupIPAddr(ctx, host); err != nil {
+			// Only cache an answer from DNS, not a timeout or network error.
+			if dnsErr, ok := err.(*net.DNSError); !ok || !dnsErr.IsNotFound {
+				return false
+			}
+			exists = false
+		}
+	}
+
+	if ev.TTL > 0 {
+		ev.mu.Lock()
+		if ev.cache == nil {
+			ev.cache = make(map[string]emailDomain)
+		}
+		if _, ok := ev.cache[host]; !ok && ev.MaxCacheSize > 0 && len(ev.cache) >= ev.MaxCacheSize {
+			ev.evictOldest()
+		}
+		ev.cache[host] = emailDomain{exists: exists, expires: time.Now().Add(ev.TTL)}
+		ev.mu.Unlock()
+	}
+	return exists
+}
+
+// evictOldest makes room in the full cache by removing the expired domains,
+// or the one which expires first if none has. ev.mu must be locked.
+func (ev *EmailVerifier) evictOldest() {
+	now := time.Now()
+	oldest := ""
+	for host, cached := range ev.cache {
+		if !now.Before(cached.expires) {
+			delete(ev.cache, host)
+		} else if oldest == "" || cached.expires.Before(ev.cache[oldest].expires) {
+			oldest = host
+		}
+	}
+	if len(ev.cache) >= ev.MaxCacheSize && oldest != "" {
+		delete(ev.cache, oldest)
+	}
+}
+
+// Reasons returned by ParseEmail when a string is not a valid email address.
+var (
+	ErrEmailEmpty               = errors.New("email is empty")
+	ErrEmailMissingAt           = errors.New("missing @ sign")
+	ErrEmailTooLong             = errors.New("email too long")
+	ErrEmailLocalPartEmpty      = errors.New("local part is empty")
+	ErrEmailLocalPartTooLong    = errors.New("local part too long")
+	ErrEmailInvalidLocalPart    = errors.New("invalid local part")
+	ErrEmailDomainEmpty         = errors.New("domain is empty")
+	ErrEmailDomainTooLong       = errors.New("domain too long")
+	ErrEmailDomainNotQualified  = errors.New("domain is not fully qualified")
+	ErrEmailInvalidDomainLabel  = errors.New("invalid domain label")
+	ErrEmailDomainLabelTooLong  = errors.New("domain label too long")
+	ErrEmailInvalidTopLevelName = errors.New("invalid top level domain")
+	ErrEmailInvalidIPLiteral    = errors.New("invalid IP literal")
+)
+
+// EmailAddress is an email address split into its parts by ParseEmail.
+// LocalPart and Domain are as written (a quoted local part keeps its quotes),
+// ASCIIDomain is the lowercased domain with any IDN labels in their xn-- form.
+type EmailAddress struct {
+	LocalPart   string
+	Domain      string
+	ASCIIDomain string
+	Quoted      bool
+	IPLiteral   bool
+}
+
+// String returns the address with the domain in ASCII form.
+func (e *EmailAddress) String() string {
+	return e.LocalPart + "@" + e.ASCIIDomain
+}
+
+// ParseEmail parses an RFC 5321/5322 email address e.g. `"john doe"@example.com`,
+// `user@[IPv6:2001:db8::1]` or `hans@müller.de`, returning why it's invalid if so.
+// The RFC 5321 length limits are enforced (64 octet local part, 255 octet domain,
+// 63 octet labels and 254 octet address).
+func ParseEmail(str string) (*EmailAddress, error) {
+	return parseEmail(str, true)
+}
+
+// IsEmailStrict checks if the string is an email address that ParseEmail accepts.
+// Unlike IsEmail it enforces the RFC length limits and only allows letters, digits
+// and hyphens in domain labels (also without a trailing dot).
+func IsEmailStrict(str string) bool {
+	_, err := ParseEmail(str)
+	return err == nil
+}
+
+func isEmailRaw(str string, params ...string) bool {
+	if len(params) == 1 && params[0] == "strict" {
+		return IsEmailStrict(str)
+	}
+	return IsEmail(str)
+}
+
+// parseEmail parses str, the lenient mode (used by IsEmail) allows an absolute
+// domain with a trailing dot, `_` and `~` in domain labels and has no length limits.
+func parseEmail(str string, strict bool) (*EmailAddress, error) {
+	if str == "" {
+		return nil, ErrEmailEmpty
+	}
+	if !utf8.ValidString(str) {
+		return nil, ErrEmailInvalidLocalPart
+	}
+	at := strings.LastIndex(str, "@")
+	if at < 0 {
+		return nil, ErrEmailMissingAt
+	}
+	email := &EmailAddress{LocalPart: str[:at], Domain: str[at+1:]}
+
+	if email.LocalPart == "" {
+		return nil, ErrEmailLocalPartEmpty
+	}
+	if strict && len(email.LocalPart) > 64 {
+		return nil, ErrEmailLocalPartTooLong
+	}
+	quoted, err := parseEmailLocalPart(email.LocalPart)
+	if err != nil {
+		return nil, err
+	}
+	email.Quoted = quoted
+
+	if email.Domain == "" {
+		return nil, ErrEmailDomainEmpty
+	}
+	if strings.HasPrefix(email.Domain, "[") {
+		if !isEmailIPLiteral(email.Domain) {
+			return nil, ErrEmailInvalidIPLiteral
+		}
+		email.IPLiteral = true
+		email.ASCIIDomain = strings.ToLower(email.Domain)
+	} else {
+		email.ASCIIDomain, err = parseEmailDomain(email.Domain, strict)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if strict {
+		if len(email.ASCIIDomain) > 255 {
+			return nil, ErrEmailDomainTooLong
+		}
+		if len(email.LocalPart)+1+len(email.ASCIIDomain) > 254 {
+			return nil, ErrEmailTooLong
+		}
+	}
+	return email, nil
+}
+
+// parseEmailLocalPart checks the local part is a dot-atom or a quoted string,
+// UTF-8 is allowed in both as per RFC 6531.
+func parseEmailLocalPart(local string) (bool, error) {
+	if len(local) >= 2 && local[0] == '"' && local[len(local)-1] == '"' {
+		escaped := false
+		for _, r := range local[1 : len(local)-1] {
+			switch {
+			case escaped:
+				if r < 0x20 && r != '\t' || r == 0x7f {
+					return true, ErrEmailInvalidLocalPart
+				}
+				escaped = false
+			case r == '\\':
+				escaped = true
+			case r == '"' || r < 0x20 && r != '\t' || r == 0x7f:
+				return true, ErrEmailInvalidLocalPart
+			}
+		}
+		if escaped {
+			return true, ErrEmailInvalidLocalPart
+		}
+		return true, nil
+	}
+
+	if local[0] == '.' || local[len(local)-1] == '.' || strings.Contains(local, "..") {
+		return false, ErrEmailInvalidLocalPart
+	}
+	for _, r := range local {
+		if r >= utf8.RuneSelf {
+			if !unicode.IsPrint(r) || unicode.IsSpace(r) {
+				return false, ErrEmailInvalidLocalPart
+			}
+		} else if r != '.' && !isEmailAtext(byte(r)) {
+			return false, ErrEmailInvalidLocalPart
+		}
+	}
+	return false, nil
+}
+
+func isEmailAtext(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' ||
+		strings.IndexByte("!#$%&'*+-/=?^_`{|}~", c) >= 0
+}
+
+// isEmailIPLiteral checks for an address literal e.g. [192.168.0.1] or [IPv6:::1].
+func isEmailIPLiteral(domain string) bool {
+	if len(domain) < 3 || domain[len(domain)-1] != ']' {
+		return false
+	}
+	literal := domain[1 : len(domain)-1]
+	if len(literal) > 5 && strings.EqualFold(literal[:5], "IPv6:") {
+		addr, err := netip.ParseAddr(literal[5:])
+		return err == nil && addr.Is6() && addr.Zone() == ""
+	}
+	addr, err := netip.ParseAddr(literal)
+	return err == nil && addr.Is4()
+}
+
+// parseEmailDomain validates a hostname with ToASCIIDomain and returns its ASCII form,
+// the lenient mode also allows `_` and `~` in labels.
+func parseEmailDomain(domain string, strict bool) (string, error) {
+	extra := ""
+	if !strict {
+		domain, extra = strings.TrimSuffix(domain, "."), "_~"
+	}
+	if !strings.Contains(domain, ".") {
+		return "", ErrEmailDomainNotQualified
+	}
+	if strings.HasSuffix(domain, ".") {
+		return "", ErrEmailInvalidDomainLabel
+	}
+	ascii, err := toASCIIDomain(domain, extra)
+	switch err {
+	case nil:
+	case ErrDomainLabelTooLong:
+		return "", ErrEmailDomainLabelTooLong
+	case ErrDomainTooLong:
+		return "", ErrEmailDomainTooLong
+	default:
+		return "", ErrEmailInvalidDomainLabel
+	}
+	if tld := ascii[strings.LastIndex(ascii, ".")+1:]; IsNumeric(tld) {
+		return "", ErrEmailInvalidTopLevelName
+	}
+	return ascii, nil
+}
@@ -0,0 +1,277 @@
+package govalidator
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeResolver resolves the domains in mx and ips without a network connection.
+type fakeResolver struct {
+	mx      map[string]bool
+	ips     map[string]bool
+	delay   time.Duration
+	lookups int
+
+	sync.Mutex
+}
+
+func newFakeResolver() *fakeResolver {
+	return &fakeResolver{
+		mx: map[string]bool{
+			"bar.com":      true,
+			"bar.com.au":   true,
+			"domain.com":   true,
+			"domain.co.uk": true,
+		},
+		ips: map[string]bool{
+			"bar.coffee": true,
+		},
+	}
+}
+
+func (r *fakeResolver) LookupMX(ctx context.Context, name string) ([]*net.MX, error) {
+	if err := r.lookup(ctx); err != nil {
+		return nil, err
+	}
+	if r.mx[name] {
+		return []*net.MX{{Host: "mx." + name, Pref: 10}}, nil
+	}
+	return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+}
+
+func (r *fakeResolver) LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error) {
+	if err := r.lookup(ctx); err != nil {
+		return nil, err
+	}
+	if r.ips[host] {
+		return []net.IPAddr{{IP: net.ParseIP("192.0.2.1")}}, nil
+	}
+	return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+}
+
+func (r *fakeResolver) lookup(ctx context.Context) error {
+	r.Lock()
+	r.lookups++
+	r.Unlock()
+
+	if ctx.Err() != nil {
+		return &net.DNSError{Err: ctx.Err().Error(), IsTimeout: true}
+	}
+	select {
+	case <-time.After(r.delay):
+		return nil
+	case <-ctx.Done():
+		return &net.DNSError{Err: ctx.Err().Error(), IsTimeout: true}
+	}
+}
+
+func (r *fakeResolver) Lookups() int {
+	r.Lock()
+	defer r.Unlock()
+	return r.lookups
+}
+
+func TestEmailVerifier(t *testing.T) {
+	t.Parallel()
+
+	verifier := NewEmailVerifier(newFakeResolver(), time.Second, 0)
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"foo@bar.com", true},
+		{"foo@BAR.COM", true},
+		{"foo@bar.coffee", true},
+		{"foo@missing.com", false},
+		{"foo@example.com", true},
+		{"foo..bar@bar.com", false},
+	}
+	for _, test := range tests {
+		actual := verifier.IsExistingEmail(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsExistingEmail(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestEmailVerifierCache(t *testing.T) {
+	t.Parallel()
+
+	resolver := newFakeResolver()
+	verifier := NewEmailVerifier(resolver, time.Second, time.Hour)
+
+	for i := 0; i < 3; i++ {
+		if !verifier.IsExistingEmail("foo@bar.com") {
+			t.Errorf("Expected IsExistingEmail(%q) to be true", "foo@bar.com")
+		}
+		if verifier.IsExistingEmail("foo@missing.com") {
+			t.Errorf("Expected IsExistingEmail(%q) to be false", "foo@missing.com")
+		}
+	}
+	// 1 MX lookup for bar.com and 1 MX + 1 IP lookup for missing.com.
+	if lookups := resolver.Lookups(); lookups != 3 {
+		t.Errorf("Expected 3 lookups with a cache, got %d", lookups)
+	}
+
+	verifier.ClearCache()
+	verifier.IsExistingEmail("foo@bar.com")
+	if lookups := resolver.Lookups(); lookups != 4 {
+		t.Errorf("Expected 4 lookups after clearing the cache, got %d", lookups)
+	}
+}
+
+func TestEmailVerifierCacheSize(t *testing.T) {
+	t.Parallel()
+
+	resolver := newFakeResolver()
+	verifier := NewEmailVerifier(resolver, time.Second, time.Hour)
+	verifier.MaxCacheSize = 2
+
+	for _, email := range []string{"foo@bar.com", "foo@bar.coffee", "foo@missing.com"} {
+		verifier.IsExistingEmail(email)
+	}
+	if size := len(verifier.cache); size != 2 {
+		t.Errorf("Expected the cache to hold 2 domains, got %d", size)
+	}
+	if _, ok := verifier.cache["bar.com"]; ok {
+		t.Errorf("Expected the oldest domain to be evicted from the cache")
+	}
+
+	verifier.TTL = time.Nanosecond
+	verifier.ClearCache()
+	verifier.IsExistingEmail("foo@bar.com")
+	time.Sleep(time.Millisecond)
+	verifier.TTL = 0
+	verifier.IsExistingEmail("foo@bar.com")
+	if size := len(verifier.cache); size != 0 {
+		t.Errorf("Expected the expired domain to be removed from the cache, got %d domains", size)
+	}
+}
+
+func TestEmailVerifierTimeout(t *testing.T) {
+	t.Parallel()
+
+	resolver := newFakeResolver()
+	resolver.delay = time.Second
+	verifier := NewEmailVerifier(resolver, 10*time.Millisecond, time.Hour)
+
+	if verifier.IsExistingEmail("foo@bar.com") {
+		t.Errorf("Expected IsExistingEmail(%q) to be false after a timeout", "foo@bar.com")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	resolver.delay = 0
+	verifier.Timeout = 0
+	if verifier.IsExistingEmailContext(ctx, "foo@bar.com") {
+		t.Errorf("Expected IsExistingEmailContext(%q) to be false when cancelled", "foo@bar.com")
+	}
+
+	// Timeouts aren't cached so the domain is looked up again.
+	if !verifier.IsExistingEmail("foo@bar.com") {
+		t.Errorf("Expected IsExistingEmail(%q) to be true", "foo@bar.com")
+	}
+}
+
+func TestParseEmail(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected error
+	}{
+		{"", ErrEmailEmpty},
+		{"foo@bar.com", nil},
+		{"Foo.Bar@Example.COM", nil},
+		{`"john..doe"@example.com`, nil},
+		{`"john\"doe"@example.com`, nil},
+		{`"john"doe"@example.com`, ErrEmailInvalidLocalPart},
+		{"hans@müller.de", nil},
+		{"user@[IPv6:2001:db8::1]", nil},
+		{"user@[127.0.0.1]", nil},
+		{"user@[::1]", ErrEmailInvalidIPLiteral},
+		{"foo.bar.com", ErrEmailMissingAt},
+		{"@bar.com", ErrEmailLocalPartEmpty},
+		{"foo@", ErrEmailDomainEmpty},
+		{".foo@bar.com", ErrEmailInvalidLocalPart},
+		{"foo bar@bar.com", ErrEmailInvalidLocalPart},
+		{strings.Repeat("a", 65) + "@bar.com", ErrEmailLocalPartTooLong},
+		{"foo@bar", ErrEmailDomainNotQualified},
+		{"foo@bar.com.", ErrEmailInvalidDomainLabel},
+		{"foo@-bar.com", ErrEmailInvalidDomainLabel},
+		{"foo@bar_baz.com", ErrEmailInvalidDomainLabel},
+		{"foo@xn--a.com", ErrEmailInvalidDomainLabel},
+		{"foo@ab--cd.com", ErrEmailInvalidDomainLabel},
+		{"foo@xn--bcher-kva.example", nil},
+		{"foo@" + strings.Repeat("a", 64) + ".com", ErrEmailDomainLabelTooLong},
+		{"foo@bar.123", ErrEmailInvalidTopLevelName},
+		{"foo@" + strings.Repeat("abcdefghi.", 26) + "com", ErrEmailDomainTooLong},
+		{strings.Repeat("a", 64) + "@" + strings.Repeat("abcdefghi.", 19) + "com", ErrEmailTooLong},
+	}
+	for _, test := range tests {
+		_, actual := ParseEmail(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected ParseEmail(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestParseEmailParts(t *testing.T) {
+	t.Parallel()
+
+	email, err := ParseEmail(`"John Doe"@Bücher.Example`)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if email.LocalPart != `"John Doe"` || !email.Quoted {
+		t.Errorf("Expected quoted local part \"John Doe\", got %s", email.LocalPart)
+	}
+	if email.Domain != "Bücher.Example" || email.ASCIIDomain != "xn--bcher-kva.example" {
+		t.Errorf("Expected domain Bücher.Example (xn--bcher-kva.example), got %s (%s)", email.Domain, email.ASCIIDomain)
+	}
+	if email.String() != `"John Doe"@xn--bcher-kva.example` {
+		t.Errorf("Expected \"John Doe\"@xn--bcher-kva.example, got %s", email.String())
+	}
+}
+
+func TestIsEmailStrict(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"foo@bar.com", true},
+		{"foo@bar.com.", false},
+		{"foo@bar_baz.com", false},
+		{strings.Repeat("a", 65) + "@bar.com", false},
+	}
+	for _, test := range tests {
+		actual := IsEmailStrict(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsEmailStrict(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+		if lenient := IsEmail(test.param); !lenient {
+			t.Errorf("Expected IsEmail(%q) to be true, got false", test.param)
+		}
+	}
+}
+
+func TestValidateEmailStrict(t *testing.T) {
+	type Account struct {
+		Email string `valid:"email(strict)"`
+	}
+
+	if ok, err := Validate(Account{"foo@bar_baz.com"}); ok {
+		t.Errorf("Expected email(strict) to fail, got %v", err)
+	}
+	if ok, err := Validate(Account{"foo@bar.com"}); !ok {
+		t.Errorf("Expected email(strict) to pass, got %v", err)
+	}
+}
@@ -0,0 +1,165 @@
+package govalidator
+
+import (
+	"encoding/base32"
+	"encoding/base64"
+	"strconv"
+	"strings"
+)
+
+// HashLengths maps hash algorithms (lowercase) to the length of their hex digests, used by IsHash and the `hash(algo)` tag.
+// Algorithms can be added e.g.
+//
+//	govalidator.HashLengths["sm3"] = 64
+var HashLengths = map[string]int{
+	"crc32":       8,
+	"crc32b":      8,
+	"crc64":       16,
+	"md4":         32,
+	"md5":         32,
+	"ripemd128":   32,
+	"ripemd160":   40,
+	"ripemd256":   64,
+	"ripemd320":   80,
+	"tiger128":    32,
+	"tiger160":    40,
+	"tiger192":    48,
+	"sha1":        40,
+	"sha224":      56,
+	"sha256":      64,
+	"sha384":      96,
+	"sha512":      128,
+	"sha512-224":  56,
+	"sha512-256":  64,
+	"sha3-224":    56,
+	"sha3-256":    64,
+	"sha3-384":    96,
+	"sha3-512":    128,
+	"keccak256":   64,
+	"blake2s":     64,
+	"blake2s-256": 64,
+	"blake2b":     128,
+	"blake2b-256": 64,
+	"blake2b-384": 96,
+	"blake2b-512": 128,
+	"blake3":      64,
+	"xxh32":       8,
+	"xxhash32":    8,
+	"xxh64":       16,
+	"xxhash":      16,
+	"xxhash64":    16,
+	"xxh3":        16,
+	"xxh128":      32,
+}
+
+// Letter cases of the hex digests accepted by IsHashCase.
+const (
+	HashLowerCase = "lower"
+	HashUpperCase = "upper"
+	HashAnyCase   = "any"
+)
+
+// IsHashCase checks if a string is a hex digest of the algorithm (see HashLengths) in the letter case
+// (HashLowerCase, HashUpperCase or HashAnyCase).
+func IsHashCase(str, algorithm, letterCase string) bool {
+	length, ok := HashLengths[strings.ToLower(algorithm)]
+	if !ok || len(str) != length {
+		return false
+	}
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		switch {
+		case '0' <= c && c <= '9':
+		case 'a' <= c && c <= 'f':
+			if letterCase != HashLowerCase && letterCase != HashAnyCase {
+				return false
+			}
+		case 'A' <= c && c <= 'F':
+			if letterCase != HashUpperCase && letterCase != HashAnyCase {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// IsHexLength checks if a string is hexadecimal of the given number of characters e.g. 32 for a 16 byte key.
+func IsHexLength(str string, length int) bool {
+	return len(str) == length && IsHexadecimal(str)
+}
+
+// IsBase64Strict checks if a string is padded base64 (RFC 4648) whose padding bits are zero,
+// i.e. it is what encoding/base64 would encode e.g. "YQ==" but not "YR==".
+func IsBase64Strict(str string) bool {
+	return isEncoded(str, base64.StdEncoding.Strict().DecodeString)
+}
+
+// IsBase64URL checks if a string is padded base64 using the URL and filename safe alphabet (RFC 4648) e.g. "_-8=".
+func IsBase64URL(str string) bool {
+	return isEncoded(str, base64.URLEncoding.Strict().DecodeString)
+}
+
+// IsBase64RawURL checks if a string is unpadded base64 using the URL and filename safe alphabet, as used by JWTs.
+func IsBase64RawURL(str string) bool {
+	return isEncoded(str, base64.RawURLEncoding.Strict().DecodeString)
+}
+
+// IsBase32 checks if a string is padded base32 (RFC 4648) e.g. "MZXW6===".
+func IsBase32(str string) bool {
+	return isEncoded(str, base32.StdEncoding.DecodeString)
+}
+
+// base58Alphabet is the Bitcoin base58 alphabet, which leaves out 0, O, I and l.
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// IsBase58 checks if a string is base58 using the Bitcoin alphabet e.g. "3mJr7AoUXx2Wqd".
+func IsBase58(str string) bool {
+	if str == "" {
+		return false
+	}
+	for i := 0; i < len(str); i++ {
+		if strings.IndexByte(base58Alphabet, str[i]) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// isEncoded checks a non empty string decodes without errors. Line breaks are rejected
+// as the encoding/base64 and encoding/base32 decoders ignore them.
+func isEncoded(str string, decode func(string) ([]byte, error)) bool {
+	if str == "" || strings.ContainsAny(str, "\r\n") {
+		return false
+	}
+	_, err := decode(str)
+	return err == nil
+}
+
+func isHashRaw(str string, params ...string) bool {
+	if len(params) != 1 {
+		return false
+	}
+	options := strings.Split(params[0], "|")
+	letterCase := HashLowerCase
+	if len(options) == 2 {
+		letterCase = options[1]
+	}
+	return IsHashCase(str, options[0], letterCase)
+}
+
+func isHexLengthRaw(str string, params ...string) bool {
+	if len(params) == 1 {
+		length, err := strconv.Atoi(params[0])
+		return err == nil && IsHexLength(str, length)
+	}
+	return false
+}
+
+func isBase64Raw(str string, params ...string) bool {
+	if len(params) == 1 && params[0] == "strict" {
+		return IsBase64Strict(str)
+	}
+	return false
+}
@@ -0,0 +1,187 @@
+package govalidator
+
+import "testing"
+
+func TestIsHashCase(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param      string
+		algorithm  string
+		letterCase string
+		expected   bool
+	}{
+		{"3a985da74fe225b2045c172d6bd390bd855f086e3e9d525b46bfe24511431532", "sha3-256", HashLowerCase, true},
+		{"3A985DA74FE225B2045C172D6BD390BD855F086E3E9D525B46BFE24511431532", "sha3-256", HashLowerCase, false},
+		{"3A985DA74FE225B2045C172D6BD390BD855F086E3E9D525B46BFE24511431532", "SHA3-256", HashUpperCase, true},
+		{"3a985da74fe225b2045c172d6bd390bd855f086e3e9d525b46bfe24511431532", "sha3-256", HashUpperCase, false},
+		{"3a985da74fe225b2045c172d6bd390bd855F086E3E9D525B46BFE24511431532", "sha3-256", HashAnyCase, true},
+		{"3a985da74fe225b2045c172d6bd390bd855f086e3e9d525b46bfe2451143153", "sha3-256", HashLowerCase, false},
+		{"d14a028c2a3a2bc9476102bb288234c415a2b01f828ea62ac5b3e42f", "sha224", HashLowerCase, true},
+		{"6ed645ef0e1daea0fb5a3a1ec1b2c2ba4fb7f4b3c2d6b2d5c3e1b6c8d2b4a1f0", "blake2b-256", HashLowerCase, true},
+		{"786a02f742015903c6c6fd852552d272912f4740e15847618a86e217f71f5419d25e1031afee585313896444934eb04b903a685b1448b755d56f701afe9be2ce", "blake2b", HashLowerCase, true},
+		{"44bc2cf5ad770999", "xxh64", HashLowerCase, true},
+		{"44bc2cf5ad770999", "xxhash", HashLowerCase, true},
+		{"02cc5d05", "xxh32", HashLowerCase, true},
+		{"02cc5d05", "xxh64", HashLowerCase, false},
+		{"d41d8cd98f00b204e9800998ecf8427e", "md5", "mixed", false},
+		{"d41d8cd98f00b204e9800998ecf8427e", "md6", HashLowerCase, false},
+		{"", "crc32", HashAnyCase, false},
+		{"d41d8cd98f00b204e9800998ecf8427g", "md5", HashAnyCase, false},
+	}
+	for _, test := range tests {
+		actual := IsHashCase(test.param, test.algorithm, test.letterCase)
+		if actual != test.expected {
+			t.Errorf("Expected IsHashCase(%q, %q, %q) to be %v, got %v", test.param, test.algorithm, test.letterCase, test.expected, actual)
+		}
+	}
+}
+
+func TestIsHexLength(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		length   int
+		expected bool
+	}{
+		{"00112233445566778899aabbccddeeff", 32, true},
+		{"00112233445566778899AABBCCDDEEFF", 32, true},
+		{"00112233445566778899aabbccddeeff", 64, false},
+		{"00112233445566778899aabbccddeefg", 32, false},
+		{"0x0011", 6, false},
+		{"", 0, false},
+	}
+	for _, test := range tests {
+		actual := IsHexLength(test.param, test.length)
+		if actual != test.expected {
+			t.Errorf("Expected IsHexLength(%q, %d) to be %v, got %v", test.param, test.length, test.expected, actual)
+		}
+	}
+}
+
+func TestIsBase64Variants(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param  string
+		strict bool
+		url    bool
+		rawURL bool
+	}{
+		{"YQ==", true, true, false},
+		{"YR==", false, false, false},
+		{"YQ", false, false, true},
+		{"Zm9vYmFy", true, true, true},
+		{"_-8=", false, true, false},
+		{"_-8", false, false, true},
+		{"/+8=", true, false, false},
+		{"Zm9v YmFy", false, false, false},
+		{"YQ\n==", false, false, false},
+		{"YQ==\n", false, false, false},
+		{"Zm9v\r\nYmFy", false, false, false},
+		{"ab\ncd", false, false, false},
+		{"", false, false, false},
+	}
+	for _, test := range tests {
+		if actual := IsBase64Strict(test.param); actual != test.strict {
+			t.Errorf("Expected IsBase64Strict(%q) to be %v, got %v", test.param, test.strict, actual)
+		}
+		if actual := IsBase64URL(test.param); actual != test.url {
+			t.Errorf("Expected IsBase64URL(%q) to be %v, got %v", test.param, test.url, actual)
+		}
+		if actual := IsBase64RawURL(test.param); actual != test.rawURL {
+			t.Errorf("Expected IsBase64RawURL(%q) to be %v, got %v", test.param, test.rawURL, actual)
+		}
+	}
+}
+
+func TestIsBase32(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"MZXW6===", true},
+		{"MZXW6YTBOI======", true},
+		{"MZXW6YQ=", true},
+		{"MZXW6", false},
+		{"mzxw6===", false},
+		{"MZXW1===", false},
+		{"MZXW6===\n", false},
+		{"MZXW\r\n6===", false},
+		{"", false},
+	}
+	for _, test := range tests {
+		actual := IsBase32(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsBase32(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestIsBase58(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"3mJr7AoUXx2Wqd", true},
+		{"1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa", true},
+		{"0abc", false},
+		{"OIl", false},
+		{"abc+", false},
+		{"", false},
+	}
+	for _, test := range tests {
+		actual := IsBase58(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsBase58(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestValidateEncoding(t *testing.T) {
+	type Artifact struct {
+		Digest    string `valid:"hash(sha3-256)"`
+		Checksum  string `valid:"hash(sha256|upper)"`
+		Key       string `valid:"hex(32)"`
+		Payload   string `valid:"base64(strict)"`
+		Token     string `valid:"base64rawurl"`
+		Address   string `valid:"base58"`
+		Secret    string `valid:"base32"`
+		Signature string `valid:"base64url"`
+	}
+
+	valid := Artifact{
+		"3a985da74fe225b2045c172d6bd390bd855f086e3e9d525b46bfe24511431532",
+		"E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855",
+		"00112233445566778899aabbccddeeff",
+		"YQ==",
+		"_-8",
+		"3mJr7AoUXx2Wqd",
+		"MZXW6===",
+		"_-8=",
+	}
+	if ok, err := Validate(valid); !ok {
+		t.Errorf("Expected artifact to pass, got %v", err)
+	}
+
+	invalid := valid
+	invalid.Checksum = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	if ok, _ := Validate(invalid); ok {
+		t.Error("Expected artifact with lowercase checksum to fail")
+	}
+	invalid = valid
+	invalid.Payload = "YR=="
+	if ok, _ := Validate(invalid); ok {
+		t.Error("Expected artifact with non-zero padding bits to fail")
+	}
+	invalid = valid
+	invalid.Key = "0011"
+	if ok, _ := Validate(invalid); ok {
+		t.Error("Expected artifact with short key to fail")
+	}
+}
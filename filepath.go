@@ -0,0 +1,116 @@
+package govalidator
+
+import "strings"
+
+// windowsReservedNames are the device names Windows doesn't allow as file names, with or without an extension.
+var windowsReservedNames = map[string]bool{
+	"CON": true, "PRN": true, "AUX": true, "NUL": true,
+	"COM1": true, "COM2": true, "COM3": true, "COM4": true, "COM5": true, "COM6": true, "COM7": true, "COM8": true, "COM9": true,
+	"LPT1": true, "LPT2": true, "LPT3": true, "LPT4": true, "LPT5": true, "LPT6": true, "LPT7": true, "LPT8": true, "LPT9": true,
+}
+
+// IsFilename checks if a string is a file name which is portable between Unix and Windows: at most 255 bytes
+// without path separators, control characters or <>:"|?*, not a reserved device name such as CON or nul.txt,
+// not ending in a space or dot and not . or ..
+func IsFilename(str string) bool {
+	if str == "" || len(str) > 255 || str == "." || str == ".." {
+		return false
+	}
+	for i := 0; i < len(str); i++ {
+		if c := str[i]; c < 0x20 || c == 0x7f || strings.IndexByte(`/\<>:"|?*`, c) >= 0 {
+			return false
+		}
+	}
+	if last := str[len(str)-1]; last == ' ' || last == '.' {
+		return false
+	}
+	name := str
+	if i := strings.IndexByte(name, '.'); i >= 0 {
+		name = name[:i]
+	}
+	return !windowsReservedNames[strings.ToUpper(strings.TrimRight(name, " "))]
+}
+
+// IsRelPath checks if a string is a relative path (with / or \ separators) which stays within its base directory,
+// i.e. it isn't absolute, has no drive letter and no .. escapes the base e.g. a/../b is valid but a/../../b isn't.
+// It can be used to check the names of archive entries against zip slip.
+func IsRelPath(str string) bool {
+	if str == "" || strings.IndexByte(str, 0) >= 0 || str[0] == '/' || str[0] == '\\' || hasDriveLetter(str) {
+		return false
+	}
+	depth := 0
+	for _, element := range strings.FieldsFunc(str, isPathSeparator) {
+		switch element {
+		case ".":
+		case "..":
+			if depth--; depth < 0 {
+				return false
+			}
+		default:
+			depth++
+		}
+	}
+	return true
+}
+
+// IsAbsPath checks if a string is an absolute Unix path e.g. /var/log, or an absolute Windows path with a drive letter
+// e.g. C:\Windows or a UNC share e.g. \\server\share\file.
+func IsAbsPath(str string) bool {
+	if strings.IndexByte(str, 0) >= 0 {
+		return false
+	}
+	switch {
+	case strings.HasPrefix(str, `\\`):
+		parts := strings.SplitN(str[2:], `\`, 3)
+		return len(parts) >= 2 && parts[0] != "" && parts[1] != ""
+	case strings.HasPrefix(str, "/"):
+		return true
+	}
+	return hasDriveLetter(str) && len(str) > 2 && isPathSeparator(rune(str[2]))
+}
+
+// IsFileExtension checks if the name of the file at the end of a path has one of the extensions, ignoring case
+// e.g. IsFileExtension("photo.JPG", "jpg", "png"). An extension can have several parts e.g. tar.gz.
+func IsFileExtension(str string, extensions ...string) bool {
+	name := str
+	if i := strings.LastIndexAny(name, `/\`); i >= 0 {
+		name = name[i+1:]
+	}
+	name = strings.ToLower(name)
+	for _, ext := range extensions {
+		ext = "." + strings.ToLower(strings.TrimPrefix(ext, "."))
+		if len(name) > len(ext) && strings.HasSuffix(name, ext) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasDriveLetter(str string) bool {
+	return len(str) >= 2 && str[1] == ':' && ('a' <= str[0] && str[0] <= 'z' || 'A' <= str[0] && str[0] <= 'Z')
+}
+
+func isPathSeparator(r rune) bool {
+	return r == '/' || r == '\\'
+}
+
+func isFilePathRaw(str string, params ...string) bool {
+	ok, osType := IsFilePath(str)
+	if len(params) == 0 || params[0] == "" {
+		return ok
+	}
+	switch params[0] {
+	case "unix":
+		return ok && osType == Unix
+	case "windows":
+		return ok && osType == Win
+	}
+	return false
+}
+
+func isFileExtensionRaw(str string, params ...string) bool {
+	if len(params) == 1 {
+		return IsFileExtension(str, strings.Split(params[0], "|")...)
+	}
+	return false
+}
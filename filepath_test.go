@@ -0,0 +1,165 @@
+package govalidator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIsFilename(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"report.pdf", true},
+		{".gitignore", true},
+		{"naïve résumé.docx", true},
+		{"CONSOLE.txt", true},
+		{"com10", true},
+		{strings.Repeat("a", 255), true},
+		{strings.Repeat("a", 256), false},
+		{"", false},
+		{".", false},
+		{"..", false},
+		{"dir/file", false},
+		{"dir\\file", false},
+		{"what?.txt", false},
+		{"a:b", false},
+		{"tab\tname", false},
+		{"CON", false},
+		{"nul.txt", false},
+		{"Com1.tar.gz", false},
+		{"LPT9", false},
+		{"trailing.", false},
+		{"trailing ", false},
+	}
+	for _, test := range tests {
+		actual := IsFilename(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsFilename(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestIsRelPath(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"file.txt", true},
+		{"dir/file.txt", true},
+		{"dir\\sub\\file.txt", true},
+		{"./dir/./file", true},
+		{"a/../b", true},
+		{"a/b/../../c", true},
+		{"..", false},
+		{"../etc/passwd", false},
+		{"a/../../b", false},
+		{"a\\..\\..\\b", false},
+		{"/etc/passwd", false},
+		{"\\Windows", false},
+		{"C:\\Windows", false},
+		{"C:file", false},
+		{"file\x00.txt", false},
+		{"", false},
+	}
+	for _, test := range tests {
+		actual := IsRelPath(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsRelPath(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestIsAbsPath(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"/", true},
+		{"/var/log/syslog", true},
+		{"C:\\", true},
+		{"c:/Users/me", true},
+		{"\\\\server\\share", true},
+		{"\\\\server\\share\\dir\\file", true},
+		{"\\\\server", false},
+		{"\\\\\\share", false},
+		{"C:", false},
+		{"C:file", false},
+		{"var/log", false},
+		{"\\Windows", false},
+		{"/var/\x00", false},
+		{"", false},
+	}
+	for _, test := range tests {
+		actual := IsAbsPath(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsAbsPath(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestIsFileExtension(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param      string
+		extensions []string
+		expected   bool
+	}{
+		{"photo.jpg", []string{"jpg", "png"}, true},
+		{"photo.PNG", []string{"jpg", "png"}, true},
+		{"/uploads/photo.jpeg", []string{".jpeg"}, true},
+		{"C:\\backup\\site.tar.gz", []string{"tar.gz"}, true},
+		{"site.tar.gz", []string{"gz"}, true},
+		{"site.tar.gz", []string{"tar"}, false},
+		{"photo.jpg.exe", []string{"jpg"}, false},
+		{"jpg", []string{"jpg"}, false},
+		{".jpg", []string{"jpg"}, false},
+		{"photo.jpg/", []string{"jpg"}, false},
+		{"photo", []string{"jpg"}, false},
+	}
+	for _, test := range tests {
+		actual := IsFileExtension(test.param, test.extensions...)
+		if actual != test.expected {
+			t.Errorf("Expected IsFileExtension(%q, %q) to be %v, got %v", test.param, test.extensions, test.expected, actual)
+		}
+	}
+}
+
+func TestValidateFilePath(t *testing.T) {
+	type Upload struct {
+		Source string `valid:"filepath"`
+		Target string `valid:"filepath(unix)"`
+		Backup string `valid:"filepath(windows),optional"`
+		Name   string `valid:"filename,ext(jpg|png)"`
+		Entry  string `valid:"relpath"`
+		Root   string `valid:"abspath"`
+	}
+
+	var tests = []struct {
+		param    Upload
+		expected bool
+	}{
+		{Upload{"C:\\tmp\\a.png", "/srv/a.png", "D:\\backup", "a.png", "img/a.png", "\\\\nas\\photos"}, true},
+		{Upload{"/tmp/a.png", "/srv/a.png", "", "a.JPG", "a.png", "/srv"}, true},
+		{Upload{"tmp/a.png", "/srv/a.png", "", "a.png", "a.png", "/srv"}, false},
+		{Upload{"/tmp/a.png", "C:\\srv\\a.png", "", "a.png", "a.png", "/srv"}, false},
+		{Upload{"/tmp/a.png", "/srv/a.png", "/backup", "a.png", "a.png", "/srv"}, false},
+		{Upload{"/tmp/a.png", "/srv/a.png", "", "a.gif", "a.png", "/srv"}, false},
+		{Upload{"/tmp/a.png", "/srv/a.png", "", "aux.png", "a.png", "/srv"}, false},
+		{Upload{"/tmp/a.png", "/srv/a.png", "", "a.png", "../a.png", "/srv"}, false},
+		{Upload{"/tmp/a.png", "/srv/a.png", "", "a.png", "a.png", "srv"}, false},
+	}
+	for _, test := range tests {
+		actual, err := Validate(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected Validate(%+v) to be %v, got %v %v", test.param, test.expected, actual, err)
+		}
+	}
+}
@@ -0,0 +1,263 @@
+package govalidator
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// LatLng is a coordinate pair in degrees. Its text form is "lat,lng" e.g. "51.5074,-0.1278",
+// so struct fields of this type can use the `latlng` and `within_bbox` validators.
+type LatLng struct {
+	Lat float64
+	Lng float64
+}
+
+// ParseLatLng parses a "lat,lng" coordinate pair e.g. "51.5074,-0.1278" or "51.5074, -0.1278".
+func ParseLatLng(str string) (LatLng, error) {
+	parts := strings.Split(str, ",")
+	if len(parts) != 2 {
+		return LatLng{}, errors.New("coordinates must be a lat,lng pair")
+	}
+	lat, lng := parts[0], strings.TrimPrefix(parts[1], " ")
+	if !IsLatitude(lat) || !IsLongitude(lng) {
+		return LatLng{}, errors.New("invalid coordinates " + str)
+	}
+	var p LatLng
+	p.Lat, _ = strconv.ParseFloat(lat, 64)
+	p.Lng, _ = strconv.ParseFloat(lng, 64)
+	return p, nil
+}
+
+// String returns the "lat,lng" form of the coordinates.
+func (p LatLng) String() string {
+	return strconv.FormatFloat(p.Lat, 'f', -1, 64) + "," + strconv.FormatFloat(p.Lng, 'f', -1, 64)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p LatLng) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *LatLng) UnmarshalText(text []byte) error {
+	parsed, err := ParseLatLng(string(text))
+	if err != nil {
+		return err
+	}
+	*p = parsed
+	return nil
+}
+
+// IsLatLng checks if the string is a "lat,lng" coordinate pair e.g. "51.5074,-0.1278".
+func IsLatLng(str string) bool {
+	_, err := ParseLatLng(str)
+	return err == nil
+}
+
+// IsWithinBBox checks if the string is a "lat,lng" coordinate pair within the bounding box (inclusive).
+// A box whose minLng is greater than its maxLng crosses the antimeridian e.g. 170 to -170.
+func IsWithinBBox(str string, minLat, minLng, maxLat, maxLng float64) bool {
+	p, err := ParseLatLng(str)
+	if err != nil || p.Lat < minLat || p.Lat > maxLat {
+		return false
+	}
+	if minLng <= maxLng {
+		return minLng <= p.Lng && p.Lng <= maxLng
+	}
+	return p.Lng >= minLng || p.Lng <= maxLng
+}
+
+// geohashAlphabet is the base32 alphabet of geohashes.
+const geohashAlphabet = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// IsGeohash checks if the string is a geohash of 1 to 12 characters e.g. gcpvj0duq.
+func IsGeohash(str string) bool {
+	if len(str) < 1 || len(str) > 12 {
+		return false
+	}
+	for i := 0; i < len(str); i++ {
+		if strings.IndexByte(geohashAlphabet, str[i]) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// IsGeoJSON checks if the string is a GeoJSON object (RFC 7946): a geometry, Feature or FeatureCollection.
+// Positions must have a longitude and latitude in range (and an optional altitude), LineStrings at least
+// two positions and Polygons closed linear rings of at least four positions.
+func IsGeoJSON(str string) bool {
+	var object map[string]interface{}
+	if err := json.Unmarshal([]byte(str), &object); err != nil {
+		return false
+	}
+	return isGeoJSONObject(object)
+}
+
+func isGeoJSONObject(object map[string]interface{}) bool {
+	if !isGeoJSONBBox(object["bbox"]) {
+		return false
+	}
+	switch object["type"] {
+	case "Feature":
+		return isGeoJSONFeature(object)
+	case "FeatureCollection":
+		features, ok := object["features"].([]interface{})
+		if !ok {
+			return false
+		}
+		for _, f := range features {
+			feature, ok := f.(map[string]interface{})
+			if !ok || feature["type"] != "Feature" || !isGeoJSONObject(feature) {
+				return false
+			}
+		}
+		return true
+	}
+	return isGeoJSONGeometry(object)
+}
+
+func isGeoJSONFeature(feature map[string]interface{}) bool {
+	properties, ok := feature["properties"]
+	if _, isObject := properties.(map[string]interface{}); !ok || properties != nil && !isObject {
+		return false
+	}
+	if id, ok := feature["id"]; ok {
+		switch id.(type) {
+		case string, float64:
+		default:
+			return false
+		}
+	}
+	geometry, ok := feature["geometry"]
+	if !ok {
+		return false
+	}
+	if geometry == nil {
+		return true
+	}
+	object, ok := geometry.(map[string]interface{})
+	return ok && isGeoJSONGeometry(object)
+}
+
+func isGeoJSONGeometry(geometry map[string]interface{}) bool {
+	if !isGeoJSONBBox(geometry["bbox"]) {
+		return false
+	}
+	if geometry["type"] == "GeometryCollection" {
+		geometries, ok := geometry["geometries"].([]interface{})
+		if !ok {
+			return false
+		}
+		for _, g := range geometries {
+			object, ok := g.(map[string]interface{})
+			if !ok || !isGeoJSONGeometry(object) {
+				return false
+			}
+		}
+		return true
+	}
+	coordinates := geometry["coordinates"]
+	switch geometry["type"] {
+	case "Point":
+		return isGeoJSONPosition(coordinates)
+	case "MultiPoint":
+		return isGeoJSONArrayOf(coordinates, 0, isGeoJSONPosition)
+	case "LineString":
+		return isGeoJSONLineString(coordinates)
+	case "MultiLineString":
+		return isGeoJSONArrayOf(coordinates, 0, isGeoJSONLineString)
+	case "Polygon":
+		return isGeoJSONPolygon(coordinates)
+	case "MultiPolygon":
+		return isGeoJSONArrayOf(coordinates, 0, isGeoJSONPolygon)
+	}
+	return false
+}
+
+// isGeoJSONArrayOf checks v is an array of at least min elements which are all valid.
+func isGeoJSONArrayOf(v interface{}, min int, valid func(interface{}) bool) bool {
+	array, ok := v.([]interface{})
+	if !ok || len(array) < min {
+		return false
+	}
+	for _, element := range array {
+		if !valid(element) {
+			return false
+		}
+	}
+	return true
+}
+
+func isGeoJSONPosition(v interface{}) bool {
+	position, ok := v.([]interface{})
+	if !ok || len(position) < 2 || len(position) > 3 {
+		return false
+	}
+	for _, n := range position {
+		if _, ok := n.(float64); !ok {
+			return false
+		}
+	}
+	lng, lat := position[0].(float64), position[1].(float64)
+	return -180 <= lng && lng <= 180 && -90 <= lat && lat <= 90
+}
+
+func isGeoJSONLineString(v interface{}) bool {
+	return isGeoJSONArrayOf(v, 2, isGeoJSONPosition)
+}
+
+// isGeoJSONLinearRing checks v is a closed LineString of at least four positions.
+func isGeoJSONLinearRing(v interface{}) bool {
+	if !isGeoJSONArrayOf(v, 4, isGeoJSONPosition) {
+		return false
+	}
+	ring := v.([]interface{})
+	first, last := ring[0].([]interface{}), ring[len(ring)-1].([]interface{})
+	if len(first) != len(last) {
+		return false
+	}
+	for i := range first {
+		if first[i] != last[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func isGeoJSONPolygon(v interface{}) bool {
+	return isGeoJSONArrayOf(v, 0, isGeoJSONLinearRing)
+}
+
+// isGeoJSONBBox checks the optional bbox member has 4 (or 6 with altitudes) numbers.
+func isGeoJSONBBox(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+	bbox, ok := v.([]interface{})
+	if !ok || len(bbox) != 4 && len(bbox) != 6 {
+		return false
+	}
+	for _, n := range bbox {
+		if _, ok := n.(float64); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func isWithinBBoxRaw(str string, params ...string) bool {
+	if len(params) == 4 {
+		var bbox [4]float64
+		for i, param := range params {
+			var err error
+			if bbox[i], err = ToFloat(param); err != nil {
+				return false
+			}
+		}
+		return IsWithinBBox(str, bbox[0], bbox[1], bbox[2], bbox[3])
+	}
+	return false
+}
@@ -0,0 +1,177 @@
+package govalidator
+
+import "testing"
+
+func TestParseLatLng(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected LatLng
+		valid    bool
+	}{
+		{"51.5074,-0.1278", LatLng{51.5074, -0.1278}, true},
+		{"51.5074, -0.1278", LatLng{51.5074, -0.1278}, true},
+		{"-90,180", LatLng{-90, 180}, true},
+		{"+45.5,+120", LatLng{45.5, 120}, true},
+		{"91,0", LatLng{}, false},
+		{"0,181", LatLng{}, false},
+		{"51.5074", LatLng{}, false},
+		{"51.5074,-0.1278,10", LatLng{}, false},
+		{"51.5074 -0.1278", LatLng{}, false},
+		{"51.5074,  -0.1278", LatLng{}, false},
+		{"", LatLng{}, false},
+	}
+	for _, test := range tests {
+		actual, err := ParseLatLng(test.param)
+		if actual != test.expected || (err == nil) != test.valid {
+			t.Errorf("Expected ParseLatLng(%q) to be %v, %v, got %v, %v", test.param, test.expected, test.valid, actual, err)
+		}
+		if IsLatLng(test.param) != test.valid {
+			t.Errorf("Expected IsLatLng(%q) to be %v", test.param, test.valid)
+		}
+	}
+}
+
+func TestLatLngText(t *testing.T) {
+	t.Parallel()
+
+	text, _ := LatLng{51.5074, -0.1278}.MarshalText()
+	if string(text) != "51.5074,-0.1278" {
+		t.Errorf("Expected LatLng.MarshalText() to be %q, got %q", "51.5074,-0.1278", text)
+	}
+	var p LatLng
+	if err := p.UnmarshalText([]byte("40.7128,-74.006")); err != nil || p != (LatLng{40.7128, -74.006}) {
+		t.Errorf("Expected LatLng.UnmarshalText to be %v, got %v, %v", LatLng{40.7128, -74.006}, p, err)
+	}
+	if err := p.UnmarshalText([]byte("north")); err == nil {
+		t.Error("Expected LatLng.UnmarshalText(\"north\") to fail")
+	}
+}
+
+func TestIsWithinBBox(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		bbox     [4]float64
+		expected bool
+	}{
+		{"51.5074,-0.1278", [4]float64{49.9, -8.2, 60.9, 1.8}, true},
+		{"49.9,-8.2", [4]float64{49.9, -8.2, 60.9, 1.8}, true},
+		{"48.8566,2.3522", [4]float64{49.9, -8.2, 60.9, 1.8}, false},
+		{"52,2.3522", [4]float64{49.9, -8.2, 60.9, 1.8}, false},
+		{"-17.7,178.1", [4]float64{-21, 170, -12, -170}, true},
+		{"-17.7,-179", [4]float64{-21, 170, -12, -170}, true},
+		{"-17.7,0", [4]float64{-21, 170, -12, -170}, false},
+		{"invalid", [4]float64{-90, -180, 90, 180}, false},
+	}
+	for _, test := range tests {
+		actual := IsWithinBBox(test.param, test.bbox[0], test.bbox[1], test.bbox[2], test.bbox[3])
+		if actual != test.expected {
+			t.Errorf("Expected IsWithinBBox(%q, %v) to be %v, got %v", test.param, test.bbox, test.expected, actual)
+		}
+	}
+}
+
+func TestIsGeohash(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"g", true},
+		{"gcpvj0duq", true},
+		{"u4pruydqqvj8", true},
+		{"u4pruydqqvj8p", false},
+		{"GCPVJ0DUQ", false},
+		{"gcpvj0aiq", false},
+		{"gcpvj lo", false},
+	}
+	for _, test := range tests {
+		actual := IsGeohash(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsGeohash(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestIsGeoJSON(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{`{"type": "Point", "coordinates": [-0.1278, 51.5074]}`, true},
+		{`{"type": "Point", "coordinates": [-0.1278, 51.5074, 11.0]}`, true},
+		{`{"type": "Point", "coordinates": [51.5074, -100]}`, false},
+		{`{"type": "Point", "coordinates": [200, 51.5]}`, false},
+		{`{"type": "Point", "coordinates": [-0.1278]}`, false},
+		{`{"type": "Point", "coordinates": ["-0.1278", "51.5074"]}`, false},
+		{`{"type": "MultiPoint", "coordinates": [[0, 0], [1, 1]]}`, true},
+		{`{"type": "LineString", "coordinates": [[0, 0], [1, 1]]}`, true},
+		{`{"type": "LineString", "coordinates": [[0, 0]]}`, false},
+		{`{"type": "MultiLineString", "coordinates": [[[0, 0], [1, 1]], [[2, 2], [3, 3]]]}`, true},
+		{`{"type": "Polygon", "coordinates": [[[0, 0], [1, 0], [1, 1], [0, 0]]]}`, true},
+		{`{"type": "Polygon", "coordinates": [[[0, 0], [1, 0], [1, 1], [0, 1]]]}`, false},
+		{`{"type": "Polygon", "coordinates": [[[0, 0], [1, 1], [0, 0]]]}`, false},
+		{`{"type": "MultiPolygon", "coordinates": [[[[0, 0], [1, 0], [1, 1], [0, 0]]]]}`, true},
+		{`{"type": "GeometryCollection", "geometries": [{"type": "Point", "coordinates": [0, 0]}]}`, true},
+		{`{"type": "GeometryCollection", "geometries": [{"type": "Feature", "geometry": null, "properties": null}]}`, false},
+		{`{"type": "Feature", "geometry": {"type": "Point", "coordinates": [0, 0]}, "properties": {"name": "Null Island"}}`, true},
+		{`{"type": "Feature", "id": 1, "geometry": null, "properties": null}`, true},
+		{`{"type": "Feature", "geometry": {"type": "Point", "coordinates": [0, 0]}}`, false},
+		{`{"type": "Feature", "geometry": {"type": "Point", "coordinates": [0, 0]}, "properties": []}`, false},
+		{`{"type": "Feature", "id": true, "geometry": null, "properties": null}`, false},
+		{`{"type": "FeatureCollection", "features": [{"type": "Feature", "geometry": null, "properties": null}]}`, true},
+		{`{"type": "FeatureCollection", "features": [{"type": "Point", "coordinates": [0, 0]}]}`, false},
+		{`{"type": "FeatureCollection", "features": []}`, true},
+		{`{"type": "Point", "coordinates": [0, 0], "bbox": [0, 0, 0, 0]}`, true},
+		{`{"type": "Point", "coordinates": [0, 0], "bbox": [0, 0]}`, false},
+		{`{"type": "Circle", "coordinates": [0, 0]}`, false},
+		{`[0, 0]`, false},
+		{``, false},
+	}
+	for _, test := range tests {
+		actual := IsGeoJSON(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsGeoJSON(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestValidateGeo(t *testing.T) {
+	type Store struct {
+		Location LatLng  `valid:"latlng,within_bbox(49.9|-8.2|60.9|1.8)"`
+		Entrance string  `valid:"within_bbox(49.9|-8.2|60.9|1.8)"`
+		Area     string  `valid:"geohash"`
+		Shape    string  `valid:"geojson"`
+		Pin      *LatLng `valid:"within_bbox(49.9|-8.2|60.9|1.8)"`
+	}
+
+	polygon := `{"type": "Polygon", "coordinates": [[[0, 51], [1, 51], [1, 52], [0, 51]]]}`
+	if ok, err := Validate(Store{LatLng{51.5074, -0.1278}, "51.5,-0.12", "gcpvj", polygon, &LatLng{51.5, 0}}); !ok {
+		t.Errorf("Expected store to pass, got %v", err)
+	}
+	if ok, err := Validate(Store{LatLng{48.8566, 2.3522}, "51.5,-0.12", "gcpvj", polygon, nil}); ok {
+		t.Errorf("Expected store outside the bounding box to fail, got %v", err)
+	}
+	if ok, err := Validate(Store{LatLng{51.5074, -0.1278}, "51.5,-0.12", "gcpvj", `{"type": "Polygon"}`, &LatLng{40, 0}}); ok {
+		t.Errorf("Expected store with invalid GeoJSON and pin to fail, got %v", err)
+	}
+
+	type Sighting struct {
+		Lat float64 `valid:"within_bbox(49.9|-8.2|60.9|1.8)" json:"lat"`
+	}
+	_, errs := Validate(Sighting{51.5})
+	expected := "Validator within_bbox(49.9|-8.2|60.9|1.8) doesn't support kind float64"
+	if actual := errs["errors"]["lat"]; len(actual) != 1 || actual[0] != expected {
+		t.Errorf("Expected float64 field to fail with %q, got %v", expected, actual)
+	}
+	if isWithinBBoxRaw("51.5,-0.12", "49.9", "-8.2", "north", "1.8") {
+		t.Errorf("Expected within_bbox with an invalid param to fail")
+	}
+}
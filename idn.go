@@ -0,0 +1,132 @@
+package govalidator
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Errors returned by ToASCIIDomain and ToUnicodeDomain.
+var (
+	ErrDomainEmpty         = errors.New("domain name is empty")
+	ErrDomainTooLong       = errors.New("domain name is longer than 253 characters")
+	ErrDomainLabelEmpty    = errors.New("domain name has an empty label")
+	ErrDomainLabelTooLong  = errors.New("domain name label is longer than 63 characters")
+	ErrDomainLabelInvalid  = errors.New("domain name label is invalid")
+	ErrDomainLabelHyphen   = errors.New("domain name label starts or ends with a hyphen")
+	ErrDomainLabelReserved = errors.New("domain name label has hyphens in the third and fourth positions")
+)
+
+// ToASCIIDomain converts an internationalized domain name to its ASCII form, lowercasing it and
+// converting Unicode labels to punycode e.g. bücher.example becomes xn--bcher-kva.example.
+// Labels must follow the IDNA2008 rules: letters, combining marks (though not first), digits and
+// hyphens (though not first, last or both third and fourth unless an xn-- label), at most 63
+// characters in ASCII and at most 253 characters in total. A trailing dot is kept.
+//
+// This is not a full IDNA2008 implementation: labels aren't NFC normalized and the bidi
+// (RFC 5893) and CONTEXTJ/CONTEXTO (RFC 5892) rules aren't checked.
+func ToASCIIDomain(str string) (string, error) {
+	return toASCIIDomain(str, "")
+}
+
+// toASCIIDomain is ToASCIIDomain also allowing the extra ASCII characters in labels e.g. _ for lenient emails.
+func toASCIIDomain(str, extra string) (string, error) {
+	name, root := strings.TrimSuffix(str, "."), strings.HasSuffix(str, ".")
+	if name == "" {
+		return "", ErrDomainEmpty
+	}
+	labels := strings.Split(name, ".")
+	for i, label := range labels {
+		ascii, err := idnLabelToASCII(label, extra)
+		if err != nil {
+			return "", err
+		}
+		labels[i] = ascii
+	}
+	name = strings.Join(labels, ".")
+	if len(name) > 253 {
+		return "", ErrDomainTooLong
+	}
+	if root {
+		name += "."
+	}
+	return name, nil
+}
+
+// ToUnicodeDomain converts a domain name to its Unicode form, decoding xn-- labels
+// e.g. xn--bcher-kva.example becomes bücher.example. The domain name must be valid (see ToASCIIDomain).
+func ToUnicodeDomain(str string) (string, error) {
+	ascii, err := ToASCIIDomain(str)
+	if err != nil {
+		return "", err
+	}
+	labels := strings.Split(ascii, ".")
+	for i, label := range labels {
+		if strings.HasPrefix(label, acePrefix) {
+			// Already checked by ToASCIIDomain.
+			labels[i], _ = punycodeDecode(label[len(acePrefix):])
+		}
+	}
+	return strings.Join(labels, "."), nil
+}
+
+// IsDomainName checks if the string is a (possibly internationalized) domain name e.g. bücher.example,
+// see ToASCIIDomain. Unlike IsDNSName, underscores and IP addresses aren't allowed.
+func IsDomainName(str string) bool {
+	_, err := ToASCIIDomain(str)
+	return err == nil && !IsIP(str)
+}
+
+// idnLabelToASCII validates a domain name label and converts it to its ASCII form.
+func idnLabelToASCII(label, extra string) (string, error) {
+	if label == "" {
+		return "", ErrDomainLabelEmpty
+	}
+	if !utf8.ValidString(label) {
+		return "", ErrDomainLabelInvalid
+	}
+	if err := checkIDNLabel(strings.ToLower(label), extra); err != nil {
+		return "", err
+	}
+	ascii, err := labelToASCII(label)
+	if err != nil {
+		return "", ErrDomainLabelInvalid
+	}
+	if strings.HasPrefix(ascii, acePrefix) {
+		decoded, err := punycodeDecode(ascii[len(acePrefix):])
+		if err != nil || IsASCII(decoded) || checkIDNLabel(decoded, extra) != nil {
+			return "", ErrDomainLabelInvalid
+		}
+	}
+	if len(ascii) > 63 {
+		return "", ErrDomainLabelTooLong
+	}
+	return ascii, nil
+}
+
+// checkIDNLabel checks a lowercase label only has letters, digits, hyphens, combining marks
+// and the extra ASCII characters in the positions IDNA2008 allows.
+func checkIDNLabel(label, extra string) error {
+	if label[0] == '-' || label[len(label)-1] == '-' {
+		return ErrDomainLabelHyphen
+	}
+	if len(label) >= 4 && label[2:4] == "--" && !strings.HasPrefix(label, acePrefix) {
+		return ErrDomainLabelReserved
+	}
+	for i, r := range label {
+		switch {
+		case r == '-', 'a' <= r && r <= 'z', '0' <= r && r <= '9':
+		case r < utf8.RuneSelf && strings.ContainsRune(extra, r):
+		case r < utf8.RuneSelf:
+			return ErrDomainLabelInvalid
+		case unicode.Is(unicode.M, r):
+			if i == 0 {
+				return ErrDomainLabelInvalid
+			}
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r), unicode.IsUpper(r):
+			return ErrDomainLabelInvalid
+		}
+	}
+	return nil
+}
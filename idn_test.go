@@ -0,0 +1,91 @@
+package govalidator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestToASCIIDomain(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected string
+		err      error
+	}{
+		{"example.com", "example.com", nil},
+		{"Example.COM.", "example.com.", nil},
+		{"bücher.example", "xn--bcher-kva.example", nil},
+		{"MÜNCHEN.de", "xn--mnchen-3ya.de", nil},
+		{"例え.jp", "xn--r8jz45g.jp", nil},
+		{"xn--bcher-kva.example", "xn--bcher-kva.example", nil},
+		{"", "", ErrDomainEmpty},
+		{".", "", ErrDomainEmpty},
+		{"example..com", "", ErrDomainLabelEmpty},
+		{"-example.com", "", ErrDomainLabelHyphen},
+		{"example-.com", "", ErrDomainLabelHyphen},
+		{"ab--cd.com", "", ErrDomainLabelReserved},
+		{"xn--a.com", "", ErrDomainLabelInvalid},
+		{"xn--abc-.com", "", ErrDomainLabelHyphen},
+		{"ex_ample.com", "", ErrDomainLabelInvalid},
+		{"exa mple.com", "", ErrDomainLabelInvalid},
+		{"́example.com", "", ErrDomainLabelInvalid},
+		{"☃.com", "", ErrDomainLabelInvalid},
+		{strings.Repeat("a", 64) + ".com", "", ErrDomainLabelTooLong},
+		{strings.Repeat("ü", 60) + ".com", "", ErrDomainLabelTooLong},
+		{strings.Repeat(strings.Repeat("a", 63)+".", 4) + "com", "", ErrDomainTooLong},
+	}
+	for _, test := range tests {
+		actual, err := ToASCIIDomain(test.param)
+		if actual != test.expected || err != test.err {
+			t.Errorf("Expected ToASCIIDomain(%q) to be %q, %v, got %q, %v", test.param, test.expected, test.err, actual, err)
+		}
+	}
+}
+
+func TestToUnicodeDomain(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected string
+	}{
+		{"xn--bcher-kva.example", "bücher.example"},
+		{"www.XN--MNCHEN-3YA.de.", "www.münchen.de."},
+		{"bücher.example", "bücher.example"},
+		{"example.com", "example.com"},
+	}
+	for _, test := range tests {
+		actual, err := ToUnicodeDomain(test.param)
+		if actual != test.expected || err != nil {
+			t.Errorf("Expected ToUnicodeDomain(%q) to be %q, got %q, %v", test.param, test.expected, actual, err)
+		}
+	}
+	if _, err := ToUnicodeDomain("xn--a.com"); err == nil {
+		t.Error("Expected ToUnicodeDomain(\"xn--a.com\") to fail")
+	}
+}
+
+func TestIsDomainName(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"localhost", true},
+		{"example.com", true},
+		{"bücher.example", true},
+		{"тест.рф", true},
+		{"127.0.0.1", false},
+		{"_service.example.com", false},
+		{"example.com:80", false},
+		{"", false},
+	}
+	for _, test := range tests {
+		actual := IsDomainName(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsDomainName(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
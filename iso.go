@@ -0,0 +1,245 @@
+package govalidator
+
+import (
+	"strings"
+	"sync"
+)
+
+// Indexes of ISO3166List, ISO693List and ISO4217List by each of their codes. They're rebuilt on the next lookup
+// when a list is appended to or replaced, but not when an entry of a list is changed in place.
+var (
+	iso3166Index struct {
+		mu     sync.Mutex
+		list   []ISO3166Entry
+		byCode map[string]ISO3166Entry
+	}
+	iso693Index struct {
+		mu     sync.Mutex
+		list   []ISO693Entry
+		byCode map[string]ISO693Entry
+	}
+	iso4217Index struct {
+		mu     sync.Mutex
+		list   []ISO4217Entry
+		byCode map[string]ISO4217Entry
+	}
+)
+
+func iso3166ByCode() map[string]ISO3166Entry {
+	iso3166Index.mu.Lock()
+	defer iso3166Index.mu.Unlock()
+	list := ISO3166List
+	if iso3166Index.byCode == nil || len(list) != len(iso3166Index.list) || len(list) > 0 && &list[0] != &iso3166Index.list[0] {
+		iso3166Index.list, iso3166Index.byCode = list, indexISO3166(list)
+	}
+	return iso3166Index.byCode
+}
+
+func iso693ByCode() map[string]ISO693Entry {
+	iso693Index.mu.Lock()
+	defer iso693Index.mu.Unlock()
+	list := ISO693List
+	if iso693Index.byCode == nil || len(list) != len(iso693Index.list) || len(list) > 0 && &list[0] != &iso693Index.list[0] {
+		iso693Index.list, iso693Index.byCode = list, indexISO693(list)
+	}
+	return iso693Index.byCode
+}
+
+func iso4217ByCode() map[string]ISO4217Entry {
+	iso4217Index.mu.Lock()
+	defer iso4217Index.mu.Unlock()
+	list := ISO4217List
+	if iso4217Index.byCode == nil || len(list) != len(iso4217Index.list) || len(list) > 0 && &list[0] != &iso4217Index.list[0] {
+		iso4217Index.list, iso4217Index.byCode = list, indexISO4217(list)
+	}
+	return iso4217Index.byCode
+}
+
+// Sets of the ISO codes in isodata.go.
+var (
+	iso639Part3Set        = codeSet(iso639Part3Codes)
+	iso639Part5Set        = codeSet(iso639Part5Codes)
+	iso3166SubdivisionSet = codeSet(iso3166SubdivisionCodes)
+	iso15924Set           = codeSet(iso15924Codes)
+)
+
+// unM49Regions are the UN M.49 codes of regions (rather than countries) used by BCP 47 e.g. 419 for Latin America.
+var unM49Regions = codeSet(`001 002 003 005 009 011 013 014 015 017 018 019 021 029 030 034 035 039 053 054 057 061
+	142 143 145 150 151 154 155 202 419`)
+
+func indexISO3166(list []ISO3166Entry) map[string]ISO3166Entry {
+	index := make(map[string]ISO3166Entry, 3*len(list))
+	for _, entry := range list {
+		index[entry.Alpha2Code] = entry
+		index[entry.Alpha3Code] = entry
+		index[entry.Numeric] = entry
+	}
+	return index
+}
+
+func indexISO693(list []ISO693Entry) map[string]ISO693Entry {
+	index := make(map[string]ISO693Entry, 2*len(list))
+	for _, entry := range list {
+		if entry.Alpha2Code != "" {
+			index[entry.Alpha2Code] = entry
+		}
+		index[entry.Alpha3bCode] = entry
+	}
+	return index
+}
+
+func indexISO4217(list []ISO4217Entry) map[string]ISO4217Entry {
+	index := make(map[string]ISO4217Entry, len(list))
+	for _, entry := range list {
+		index[entry.Code] = entry
+	}
+	return index
+}
+
+func codeSet(codes string) map[string]struct{} {
+	set := make(map[string]struct{})
+	for _, code := range strings.Fields(codes) {
+		set[code] = struct{}{}
+	}
+	return set
+}
+
+// LookupISO3166 returns the ISO3166List entry of an alpha-2 (e.g. GB), alpha-3 (e.g. GBR) or numeric (e.g. 826) country code.
+func LookupISO3166(code string) (ISO3166Entry, bool) {
+	entry, ok := iso3166ByCode()[code]
+	return entry, ok
+}
+
+// LookupISO693 returns the ISO693List entry of an ISO 639-1 (e.g. de) or ISO 639-2/B (e.g. ger) language code.
+func LookupISO693(code string) (ISO693Entry, bool) {
+	entry, ok := iso693ByCode()[code]
+	return entry, ok
+}
+
+// LookupISO4217 returns the ISO4217List entry of a currency code e.g. USD.
+func LookupISO4217(code string) (ISO4217Entry, bool) {
+	entry, ok := iso4217ByCode()[code]
+	return entry, ok
+}
+
+// IsISO3166Numeric checks if a string is valid three-digit country code e.g. 826
+func IsISO3166Numeric(str string) bool {
+	entry, ok := iso3166ByCode()[str]
+	return ok && entry.Numeric == str
+}
+
+// IsISO3166Subdivision checks if a string is valid ISO 3166-2 country subdivision code e.g. US-CA or DE-BY
+func IsISO3166Subdivision(str string) bool {
+	_, ok := iso3166SubdivisionSet[str]
+	return ok
+}
+
+// IsISO639Part3 checks if a string is valid ISO 639-3 three-letter language code e.g. cmn
+func IsISO639Part3(str string) bool {
+	_, ok := iso639Part3Set[str]
+	return ok
+}
+
+// bcp47Grandfathered are the grandfathered tags of RFC 5646 which don't follow its syntax.
+var bcp47Grandfathered = codeSet(`en-gb-oed i-ami i-bnn i-default i-enochian i-hak i-klingon i-lux i-mingo i-navajo
+	i-pwn i-tao i-tay i-tsu sgn-be-fr sgn-be-nl sgn-ch-de art-lojban cel-gaulish no-bok no-nyn zh-guoyu zh-hakka
+	zh-min zh-min-nan zh-xiang`)
+
+// IsBCP47 checks if a string is a valid BCP 47 (RFC 5646) language tag e.g. en-GB or zh-Hant-TW (case insensitive).
+// The language, extended language, script and region subtags must be ISO 639, ISO 15924 and ISO 3166-1 or
+// UN M.49 codes, variants, extensions and private use subtags (e.g. x-custom) are only checked syntactically.
+func IsBCP47(str string) bool {
+	tag := strings.ToLower(str)
+	if _, ok := bcp47Grandfathered[tag]; ok {
+		return true
+	}
+	subtags := strings.Split(tag, "-")
+	for _, subtag := range subtags {
+		if len(subtag) < 1 || len(subtag) > 8 || !IsAlphanumeric(subtag) {
+			return false
+		}
+	}
+	if subtags[0] == "x" {
+		// Private use tag e.g. x-whatever.
+		return len(subtags) > 1
+	}
+	if !isBCP47Language(subtags[0]) {
+		return false
+	}
+	i := 1
+	// Up to three extended language subtags e.g. zh-yue.
+	for n := 0; n < 3 && i < len(subtags) && len(subtags[0]) <= 3 && len(subtags[i]) == 3 && IsAlpha(subtags[i]); n++ {
+		if _, ok := iso639Part3Set[subtags[i]]; !ok {
+			return false
+		}
+		i++
+	}
+	if i < len(subtags) && len(subtags[i]) == 4 && IsAlpha(subtags[i]) {
+		if _, ok := iso15924Set[strings.ToUpper(subtags[i][:1])+subtags[i][1:]]; !ok {
+			return false
+		}
+		i++
+	}
+	if i < len(subtags) && (len(subtags[i]) == 2 && IsAlpha(subtags[i]) || len(subtags[i]) == 3 && IsNumeric(subtags[i])) {
+		if !isBCP47Region(subtags[i]) {
+			return false
+		}
+		i++
+	}
+	variants := make(map[string]bool)
+	for ; i < len(subtags) && isBCP47Variant(subtags[i]); i++ {
+		if variants[subtags[i]] {
+			return false
+		}
+		variants[subtags[i]] = true
+	}
+	singletons := make(map[string]bool)
+	for i < len(subtags) && len(subtags[i]) == 1 && subtags[i] != "x" {
+		if singletons[subtags[i]] {
+			return false
+		}
+		singletons[subtags[i]] = true
+		i++
+		start := i
+		for i < len(subtags) && len(subtags[i]) >= 2 {
+			i++
+		}
+		if i == start {
+			return false
+		}
+	}
+	if i < len(subtags) && subtags[i] == "x" {
+		return i+1 < len(subtags)
+	}
+	return i == len(subtags)
+}
+
+// isBCP47Language checks a lowercase primary language subtag is an ISO 639 code or reserved for private use (qaa-qtz).
+func isBCP47Language(language string) bool {
+	if !IsAlpha(language) {
+		return false
+	}
+	switch len(language) {
+	case 2:
+		entry, ok := iso693ByCode()[language]
+		return ok && entry.Alpha2Code == language
+	case 3:
+		_, part3 := iso639Part3Set[language]
+		_, part5 := iso639Part5Set[language]
+		return part3 || part5 || "qaa" <= language && language <= "qtz"
+	}
+	return false
+}
+
+func isBCP47Region(region string) bool {
+	if IsNumeric(region) {
+		_, ok := unM49Regions[region]
+		return ok || IsISO3166Numeric(region)
+	}
+	return IsISO3166Alpha2(strings.ToUpper(region))
+}
+
+// isBCP47Variant checks the syntax of a variant subtag: 5 to 8 characters or a digit and 3 characters e.g. 1901.
+func isBCP47Variant(subtag string) bool {
+	return len(subtag) >= 5 || len(subtag) == 4 && subtag[0] >= '0' && subtag[0] <= '9'
+}
@@ -0,0 +1,196 @@
+package govalidator
+
+import "testing"
+
+func TestLookupISO3166(t *testing.T) {
+	t.Parallel()
+
+	for _, code := range []string{"GB", "GBR", "826"} {
+		entry, ok := LookupISO3166(code)
+		if !ok || entry.Alpha2Code != "GB" || entry.Alpha3Code != "GBR" || entry.Numeric != "826" {
+			t.Errorf("Expected LookupISO3166(%q) to be the GB entry, got %+v, %v", code, entry, ok)
+		}
+	}
+	for _, code := range []string{"", "gb", "XX", "999"} {
+		if entry, ok := LookupISO3166(code); ok {
+			t.Errorf("Expected LookupISO3166(%q) to fail, got %+v", code, entry)
+		}
+	}
+}
+
+func TestLookupISO693(t *testing.T) {
+	t.Parallel()
+
+	for _, code := range []string{"de", "ger"} {
+		entry, ok := LookupISO693(code)
+		if !ok || entry.Alpha2Code != "de" || entry.Alpha3bCode != "ger" || entry.English != "German" {
+			t.Errorf("Expected LookupISO693(%q) to be the German entry, got %+v, %v", code, entry, ok)
+		}
+	}
+	for _, code := range []string{"", "DE", "deu", "zz"} {
+		if entry, ok := LookupISO693(code); ok {
+			t.Errorf("Expected LookupISO693(%q) to fail, got %+v", code, entry)
+		}
+	}
+}
+
+func TestIsISO3166Numeric(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"826", true},
+		{"004", true},
+		{"840", true},
+		{"4", false},
+		{"999", false},
+		{"GB", false},
+		{"GBR", false},
+	}
+	for _, test := range tests {
+		actual := IsISO3166Numeric(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsISO3166Numeric(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestIsISO3166Subdivision(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"US-CA", true},
+		{"DE-BY", true},
+		{"GB-ENG", true},
+		{"FR-75", true},
+		{"us-ca", false},
+		{"US-XX", false},
+		{"US", false},
+		{"USCA", false},
+	}
+	for _, test := range tests {
+		actual := IsISO3166Subdivision(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsISO3166Subdivision(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestIsISO639Part3(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"eng", true},
+		{"cmn", true},
+		{"yue", true},
+		{"deu", true},
+		{"ger", false},
+		{"en", false},
+		{"ENG", false},
+		{"zzz", false},
+	}
+	for _, test := range tests {
+		actual := IsISO639Part3(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsISO639Part3(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestIsBCP47(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"en", true},
+		{"en-GB", true},
+		{"EN-gb", true},
+		{"zh-Hant-TW", true},
+		{"zh-yue-HK", true},
+		{"sr-Latn-RS", true},
+		{"es-419", true},
+		{"de-CH-1901", true},
+		{"sl-rozaj-biske", true},
+		{"en-US-u-ca-gregory", true},
+		{"en-a-bbb-x-a-ccc", true},
+		{"qaa", true},
+		{"x-whatever", true},
+		{"i-klingon", true},
+		{"zh-min-nan", true},
+		{"sgn", true},
+		{"en-", false},
+		{"-en", false},
+		{"e", false},
+		{"zz", false},
+		{"en-ZZ", false},
+		{"en-Abcd", false},
+		{"en-999", false},
+		{"en-GB-GB", false},
+		{"de-CH-1901-1901", false},
+		{"en-u", false},
+		{"en-u-ca-u-nu", false},
+		{"en-x", false},
+		{"x", false},
+		{"en-toolongsubtag", false},
+		{"en_GB", false},
+		{"ger", false},
+	}
+	for _, test := range tests {
+		actual := IsBCP47(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsBCP47(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestValidateISOTags(t *testing.T) {
+	type Locale struct {
+		Language string `valid:"ISO693Alpha2"`
+		Country  string `valid:"ISO3166Numeric"`
+		State    string `valid:"ISO3166Subdivision"`
+		Tag      string `valid:"bcp47"`
+	}
+
+	if ok, err := Validate(Locale{"de", "276", "DE-BY", "de-DE"}); !ok {
+		t.Errorf("Expected locale to pass, got %v", err)
+	}
+	if ok, err := Validate(Locale{"ger", "DE", "DE-XX", "de_DE"}); ok {
+		t.Errorf("Expected locale to fail, got %v", err)
+	}
+}
+
+func TestISOListsAppend(t *testing.T) {
+	defer func(iso3166 []ISO3166Entry, iso693 []ISO693Entry, iso4217 []ISO4217Entry) {
+		ISO3166List, ISO693List, ISO4217List = iso3166, iso693, iso4217
+	}(ISO3166List, ISO693List, ISO4217List)
+
+	if IsISO3166Alpha2("XK") || IsISO693Alpha3b("tlh") || IsISO4217("XBT") {
+		t.Fatal("Expected the user-assigned codes not to be in the lists")
+	}
+	ISO3166List = append(ISO3166List, ISO3166Entry{"Kosovo", "Kosovo (le)", "XK", "XKX", "983"})
+	ISO693List = append(ISO693List, ISO693Entry{Alpha3bCode: "tlh", English: "Klingon"})
+	ISO4217List = append(ISO4217List, ISO4217Entry{"XBT", "000", 8})
+	if !IsISO3166Alpha2("XK") || !IsISO3166Alpha3("XKX") || !IsISO3166Numeric("983") {
+		t.Error("Expected the appended ISO3166List entry to be valid")
+	}
+	if !IsISO693Alpha3b("tlh") {
+		t.Error("Expected the appended ISO693List entry to be valid")
+	}
+	if !IsISO4217("XBT") {
+		t.Error("Expected the appended ISO4217List entry to be valid")
+	}
+}
@@ -0,0 +1,256 @@
+package govalidator
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"mime"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// MediaTypeSignatures maps media types to functions which check data starts with their magic signature.
+// The `datauri(type1|...|typeN)` and `base64image` validators check the decoded payload against it. Signatures can be added e.g.
+//
+//	govalidator.MediaTypeSignatures["application/wasm"] = func(data []byte) bool { return bytes.HasPrefix(data, []byte("\x00asm")) }
+var MediaTypeSignatures = map[string]func(data []byte) bool{
+	"image/png":                hasPrefix("\x89PNG\r\n\x1a\n"),
+	"image/jpeg":               hasPrefix("\xff\xd8\xff"),
+	"image/gif":                hasPrefix("GIF87a", "GIF89a"),
+	"image/webp":               isRIFF("WEBP"),
+	"image/bmp":                hasPrefix("BM"),
+	"image/tiff":               hasPrefix("II*\x00", "MM\x00*"),
+	"image/x-icon":             hasPrefix("\x00\x00\x01\x00"),
+	"image/vnd.microsoft.icon": hasPrefix("\x00\x00\x01\x00"),
+	"image/avif":               isFtyp("avif", "avis"),
+	"image/heic":               isFtyp("heic", "heix", "heim", "heis"),
+	"application/pdf":          hasPrefix("%PDF-"),
+	"application/zip":          hasPrefix("PK\x03\x04", "PK\x05\x06"),
+	"application/gzip":         hasPrefix("\x1f\x8b"),
+	"audio/wav":                isRIFF("WAVE"),
+	"audio/ogg":                hasPrefix("OggS"),
+	"video/mp4":                isFtyp("isom", "iso2", "mp41", "mp42", "avc1"),
+	"video/webm":               hasPrefix("\x1a\x45\xdf\xa3"),
+}
+
+func hasPrefix(signatures ...string) func([]byte) bool {
+	return func(data []byte) bool {
+		for _, signature := range signatures {
+			if bytes.HasPrefix(data, []byte(signature)) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// isRIFF checks for a RIFF container of the form e.g. WEBP.
+func isRIFF(form string) func([]byte) bool {
+	return func(data []byte) bool {
+		return len(data) >= 12 && string(data[:4]) == "RIFF" && string(data[8:12]) == form
+	}
+}
+
+// isFtyp checks for an ISO base media file (MP4, HEIF...) of one of the major brands.
+func isFtyp(brands ...string) func([]byte) bool {
+	return func(data []byte) bool {
+		if len(data) < 12 || string(data[4:8]) != "ftyp" {
+			return false
+		}
+		for _, brand := range brands {
+			if string(data[8:12]) == brand {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// isMIMEName checks a type or subtype is a restricted name (RFC 6838) e.g. svg+xml.
+func isMIMEName(str string) bool {
+	if str == "" || len(str) > 127 || !isASCIIAlphanumeric(str[0]) {
+		return false
+	}
+	for i := 1; i < len(str); i++ {
+		if !isASCIIAlphanumeric(str[i]) && strings.IndexByte("!#$&-^_.+", str[i]) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func isASCIIAlphanumeric(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+// IsMIMEType checks if a string is a media type (RFC 6838) with optional parameters, as used in a Content-Type
+// header e.g. "text/html; charset=utf-8" or "application/vnd.api+json".
+func IsMIMEType(str string) bool {
+	mediatype, _, err := mime.ParseMediaType(str)
+	if err != nil {
+		return false
+	}
+	typ, subtype, ok := strings.Cut(mediatype, "/")
+	return ok && isMIMEName(typ) && isMIMEName(subtype)
+}
+
+// matchMediaType checks a media type matches a pattern, ignoring case, where the pattern can be e.g. image/* or */*.
+func matchMediaType(mediatype, pattern string) bool {
+	pattern = strings.ToLower(pattern)
+	if pattern == "*/*" || pattern == mediatype {
+		return true
+	}
+	return strings.HasSuffix(pattern, "/*") && strings.HasPrefix(mediatype, pattern[:len(pattern)-1])
+}
+
+// ParseDataURI parses a data URI (RFC 2397) e.g. "data:image/png;base64,iVBORw0KGgo..." and returns its lowercase
+// media type without parameters, which defaults to text/plain, and its decoded data.
+// Data without ;base64 is percent-decoded.
+func ParseDataURI(str string) (mediatype string, data []byte, err error) {
+	mediatype, data, _, err = parseDataURI(str)
+	return mediatype, data, err
+}
+
+func hasDataURIScheme(str string) bool {
+	return len(str) >= 5 && strings.EqualFold(str[:5], "data:")
+}
+
+func parseDataURI(str string) (mediatype string, data []byte, isBase64 bool, err error) {
+	if !hasDataURIScheme(str) {
+		return "", nil, false, errors.New("data URI must start with data:")
+	}
+	header, payload, ok := strings.Cut(str[5:], ",")
+	if !ok {
+		return "", nil, false, errors.New("data URI has no data")
+	}
+	if isBase64 = strings.HasSuffix(header, ";base64"); isBase64 {
+		header = header[:len(header)-len(";base64")]
+	}
+	mediatype = "text/plain"
+	if header != "" {
+		if strings.HasPrefix(header, ";") {
+			header = mediatype + header
+		}
+		if !IsMIMEType(header) {
+			return "", nil, false, errors.New("invalid data URI media type " + header)
+		}
+		mediatype, _, _ = mime.ParseMediaType(header)
+	}
+	if isBase64 {
+		data, err = base64.StdEncoding.DecodeString(payload)
+	} else {
+		var unescaped string
+		unescaped, err = url.PathUnescape(payload)
+		data = []byte(unescaped)
+	}
+	if err != nil {
+		return "", nil, false, errors.New("invalid data URI data")
+	}
+	return mediatype, data, isBase64, nil
+}
+
+// IsDataURIOf checks if a string is a data URI whose media type matches one of the given ones e.g. image/png or image/*,
+// and whose data starts with the magic signature of its media type in MediaTypeSignatures. A media type without a
+// signature (e.g. image/svg+xml) is only accepted when it's given exactly, not through a wildcard such as image/*.
+func IsDataURIOf(str string, mediatypes ...string) bool {
+	mediatype, data, err := ParseDataURI(str)
+	if err != nil {
+		return false
+	}
+	signature, hasSignature := MediaTypeSignatures[mediatype]
+	for _, pattern := range mediatypes {
+		if !matchMediaType(mediatype, pattern) {
+			continue
+		}
+		if hasSignature {
+			return signature(data)
+		}
+		if strings.EqualFold(pattern, mediatype) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsMaxDecodedSize checks if a data URI or base64 string decodes to at most max bytes.
+func IsMaxDecodedSize(str string, max int) bool {
+	if hasDataURIScheme(str) {
+		_, data, err := ParseDataURI(str)
+		return err == nil && len(data) <= max
+	}
+	if base64.StdEncoding.DecodedLen(len(str)) > max+2 {
+		return false
+	}
+	data, err := base64.StdEncoding.DecodeString(str)
+	return err == nil && len(data) <= max
+}
+
+// DetectImageType returns the media type of the image format whose magic signature in MediaTypeSignatures the data
+// starts with e.g. image/png, or an empty string.
+func DetectImageType(data []byte) string {
+	mediatypes := make([]string, 0, len(MediaTypeSignatures))
+	for mediatype := range MediaTypeSignatures {
+		if strings.HasPrefix(mediatype, "image/") {
+			mediatypes = append(mediatypes, mediatype)
+		}
+	}
+	sort.Strings(mediatypes)
+	for _, mediatype := range mediatypes {
+		if MediaTypeSignatures[mediatype](data) {
+			return mediatype
+		}
+	}
+	return ""
+}
+
+// DecodeBase64Image decodes an image in a data URI e.g. "data:image/png;base64,iVBORw0KGgo..." or a plain base64
+// string, such as an avatar upload, and returns its media type. The data must have the signature of an image format
+// in MediaTypeSignatures and a data URI must declare the same type.
+func DecodeBase64Image(str string) (mediatype string, data []byte, err error) {
+	declared := ""
+	if hasDataURIScheme(str) {
+		var isBase64 bool
+		if declared, data, isBase64, err = parseDataURI(str); err != nil {
+			return "", nil, err
+		}
+		if !isBase64 {
+			return "", nil, errors.New("image data URI must be base64 encoded")
+		}
+	} else if data, err = base64.StdEncoding.DecodeString(str); err != nil || len(data) == 0 {
+		return "", nil, errors.New("image must be base64 encoded")
+	}
+	mediatype = DetectImageType(data)
+	if mediatype == "" {
+		return "", nil, errors.New("data is not a known image format")
+	}
+	if declared != "" {
+		if signature := MediaTypeSignatures[declared]; signature == nil || !signature(data) {
+			return "", nil, errors.New("image data does not match its declared type " + declared)
+		}
+		mediatype = declared
+	}
+	return mediatype, data, nil
+}
+
+// IsBase64Image checks if a string is a base64 image, as a data URI or plain base64, see DecodeBase64Image.
+func IsBase64Image(str string) bool {
+	_, _, err := DecodeBase64Image(str)
+	return err == nil
+}
+
+func isDataURIOfRaw(str string, params ...string) bool {
+	if len(params) == 1 {
+		return IsDataURIOf(str, strings.Split(params[0], "|")...)
+	}
+	return false
+}
+
+func isMaxDecodedSizeRaw(str string, params ...string) bool {
+	if len(params) == 1 {
+		max, err := strconv.Atoi(params[0])
+		return err == nil && IsMaxDecodedSize(str, max)
+	}
+	return false
+}
@@ -0,0 +1,199 @@
+package govalidator
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+)
+
+// Minimal payloads starting with the magic signatures of their formats.
+var (
+	testPNG  = "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR"
+	testJPEG = "\xff\xd8\xff\xe0\x00\x10JFIF\x00"
+	testGIF  = "GIF89a\x01\x00\x01\x00"
+	testWebP = "RIFF\x24\x00\x00\x00WEBPVP8 "
+	testPDF  = "%PDF-1.7\n"
+)
+
+func dataURI(mediatype, data string) string {
+	return "data:" + mediatype + ";base64," + base64.StdEncoding.EncodeToString([]byte(data))
+}
+
+func TestIsMIMEType(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"text/plain", true},
+		{"text/html; charset=utf-8", true},
+		{"application/vnd.api+json", true},
+		{"image/svg+xml", true},
+		{"multipart/form-data; boundary=\"----abc def\"", true},
+		{"Application/JSON", true},
+		{"text", false},
+		{"text/", false},
+		{"/plain", false},
+		{"text/plain/html", false},
+		{"text/pl ain", false},
+		{"text/plain; charset", false},
+		{"*/*", false},
+		{"", false},
+	}
+	for _, test := range tests {
+		actual := IsMIMEType(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsMIMEType(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestParseDataURI(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param     string
+		mediatype string
+		data      string
+		valid     bool
+	}{
+		{dataURI("image/png", testPNG), "image/png", testPNG, true},
+		{"data:Image/PNG;name=a.png;base64,YQ==", "image/png", "a", true},
+		{"data:,Hello%2C%20World%21", "text/plain", "Hello, World!", true},
+		{"data:;charset=utf-8,caf%C3%A9", "text/plain", "café", true},
+		{"DATA:text/plain;base64,YQ==", "text/plain", "a", true},
+		{"data:text/plain;base64", "", "", false},
+		{"data:image/png;base64,!!!", "", "", false},
+		{"data:image;base64,YQ==", "", "", false},
+		{"data:,%zz", "", "", false},
+		{"image/png;base64,YQ==", "", "", false},
+		{"", "", "", false},
+	}
+	for _, test := range tests {
+		mediatype, data, err := ParseDataURI(test.param)
+		if mediatype != test.mediatype || string(data) != test.data || (err == nil) != test.valid {
+			t.Errorf("Expected ParseDataURI(%q) to be %q, %q, %v, got %q, %q, %v", test.param, test.mediatype, test.data, test.valid, mediatype, data, err)
+		}
+	}
+}
+
+func TestIsDataURIOf(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param      string
+		mediatypes []string
+		expected   bool
+	}{
+		{dataURI("image/png", testPNG), []string{"image/png", "image/jpeg"}, true},
+		{dataURI("image/jpeg", testJPEG), []string{"image/png", "image/jpeg"}, true},
+		{dataURI("image/gif", testGIF), []string{"image/*"}, true},
+		{dataURI("image/webp", testWebP), []string{"*/*"}, true},
+		{dataURI("application/pdf", testPDF), []string{"application/pdf"}, true},
+		{dataURI("application/json", `{"a": 1}`), []string{"application/json"}, true},
+		{dataURI("image/png", testJPEG), []string{"image/png"}, false},
+		{dataURI("image/png", "<script>alert(1)</script>"), []string{"image/*"}, false},
+		{dataURI("image/gif", testGIF), []string{"image/png", "image/jpeg"}, false},
+		{dataURI("text/html", "<p>"), []string{"image/*"}, false},
+		{dataURI("image/svg+xml", `<svg onload="alert(1)"/>`), []string{"image/svg+xml"}, true},
+		{dataURI("image/svg+xml", `<svg onload="alert(1)"/>`), []string{"image/*"}, false},
+		{dataURI("image/x-foo", "anything"), []string{"image/*"}, false},
+		{dataURI("image/x-foo", "anything"), []string{"*/*"}, false},
+		{dataURI("application/json", `{"a": 1}`), []string{"*/*"}, false},
+		{dataURI("application/json", `{"a": 1}`), []string{"application/*", "application/json"}, true},
+		{"data:image/png;base64", []string{"image/png"}, false},
+	}
+	for _, test := range tests {
+		actual := IsDataURIOf(test.param, test.mediatypes...)
+		if actual != test.expected {
+			t.Errorf("Expected IsDataURIOf(%q, %q) to be %v, got %v", test.param, test.mediatypes, test.expected, actual)
+		}
+	}
+}
+
+func TestIsMaxDecodedSize(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		max      int
+		expected bool
+	}{
+		{"YWJj", 3, true},
+		{"YWJj", 2, false},
+		{"YQ==", 1, true},
+		{"YQ==", 0, false},
+		{dataURI("image/png", testPNG), len(testPNG), true},
+		{dataURI("image/png", testPNG), len(testPNG) - 1, false},
+		{"data:,abc", 3, true},
+		{base64.StdEncoding.EncodeToString(make([]byte, 1<<20)), 1 << 10, false},
+		{"not base64", 100, false},
+	}
+	for _, test := range tests {
+		actual := IsMaxDecodedSize(test.param, test.max)
+		if actual != test.expected {
+			t.Errorf("Expected IsMaxDecodedSize(%q, %d) to be %v, got %v", test.param, test.max, test.expected, actual)
+		}
+	}
+}
+
+func TestDecodeBase64Image(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param     string
+		mediatype string
+		expected  string
+	}{
+		{dataURI("image/png", testPNG), "image/png", ""},
+		{dataURI("image/webp", testWebP), "image/webp", ""},
+		{base64.StdEncoding.EncodeToString([]byte(testJPEG)), "image/jpeg", ""},
+		{base64.StdEncoding.EncodeToString([]byte(testGIF)), "image/gif", ""},
+		{dataURI("image/png", testGIF), "", "image data does not match its declared type image/png"},
+		{dataURI("text/plain", testPNG), "", "image data does not match its declared type text/plain"},
+		{dataURI("image/png", testPDF), "", "data is not a known image format"},
+		{"data:image/png," + testPNG, "", "image data URI must be base64 encoded"},
+		{base64.StdEncoding.EncodeToString([]byte(testPDF)), "", "data is not a known image format"},
+		{"not base64", "", "image must be base64 encoded"},
+		{"", "", "image must be base64 encoded"},
+	}
+	for _, test := range tests {
+		mediatype, _, err := DecodeBase64Image(test.param)
+		if mediatype != test.mediatype || test.expected == "" && err != nil || test.expected != "" && (err == nil || err.Error() != test.expected) {
+			t.Errorf("Expected DecodeBase64Image(%q) to be %q, %q, got %q, %v", test.param, test.mediatype, test.expected, mediatype, err)
+		}
+		if IsBase64Image(test.param) != (test.expected == "") {
+			t.Errorf("Expected IsBase64Image(%q) to be %v", test.param, test.expected == "")
+		}
+	}
+}
+
+func TestValidateMedia(t *testing.T) {
+	type Profile struct {
+		Avatar      string `valid:"datauri(image/png|image/jpeg),maxdecoded(64)"`
+		Photo       string `valid:"base64image,optional"`
+		ContentType string `valid:"mimetype"`
+		Attachment  string `valid:"datauri,optional"`
+	}
+
+	var tests = []struct {
+		param    Profile
+		expected bool
+	}{
+		{Profile{dataURI("image/png", testPNG), "", "image/png", ""}, true},
+		{Profile{dataURI("image/jpeg", testJPEG), dataURI("image/gif", testGIF), "text/html; charset=utf-8", dataURI("image/png", "x")}, true},
+		{Profile{dataURI("image/gif", testGIF), "", "image/gif", ""}, false},
+		{Profile{dataURI("image/png", testJPEG), "", "image/png", ""}, false},
+		{Profile{dataURI("image/png", testPNG+strings.Repeat("\x00", 64)), "", "image/png", ""}, false},
+		{Profile{dataURI("image/png", testPNG), base64.StdEncoding.EncodeToString([]byte(testPDF)), "image/png", ""}, false},
+		{Profile{dataURI("image/png", testPNG), "", "image", ""}, false},
+		{Profile{dataURI("image/png", testPNG), "", "image/png", "data:image/png;base64"}, false},
+	}
+	for _, test := range tests {
+		actual, err := Validate(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected Validate(%+v) to be %v, got %v %v", test.param, test.expected, actual, err)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package govalidator
+
+import (
+	"strconv"
+	"strings"
+)
+
+// decimalDigits returns the number of integer digits (without leading zeros) and fraction digits
+// (as written, including trailing zeros) of a decimal number e.g. 2 and 3 for -012.500.
+// An exponent, as used by fmt for large and small floats, is applied e.g. 1.5e-3 has 0 and 4.
+func decimalDigits(str string) (integer, fraction int, ok bool) {
+	if strings.HasPrefix(str, "-") || strings.HasPrefix(str, "+") {
+		str = str[1:]
+	}
+	exp := 0
+	if i := strings.IndexAny(str, "eE"); i >= 0 {
+		var err error
+		if exp, err = strconv.Atoi(str[i+1:]); err != nil || exp > 1000 || exp < -1000 {
+			return 0, 0, false
+		}
+		str = str[:i]
+	}
+	intPart, fracPart := str, ""
+	if i := strings.IndexByte(str, '.'); i >= 0 {
+		intPart, fracPart = str[:i], str[i+1:]
+		if fracPart == "" {
+			return 0, 0, false
+		}
+	}
+	if intPart == "" || !IsNumeric(intPart) || !IsNumeric(fracPart) {
+		return 0, 0, false
+	}
+	digits := intPart + fracPart
+	point := len(intPart) + exp
+	lead := 0
+	for lead < len(digits) && lead < point && digits[lead] == '0' {
+		lead++
+	}
+	switch {
+	case point <= 0:
+		return 0, len(digits) - point, true
+	case point >= len(digits):
+		if lead == len(digits) {
+			return 0, 0, true
+		}
+		return point - lead, 0, true
+	}
+	return point - lead, len(digits) - point, true
+}
+
+// IsDecimal checks if the string is a decimal number e.g. -123.45 that fits the precision (the max number of digits)
+// and scale (the max number of digits after the decimal point) of an SQL DECIMAL(precision, scale) column.
+func IsDecimal(str string, precision, scale int) bool {
+	integer, fraction, ok := decimalDigits(str)
+	return ok && fraction <= scale && integer <= precision-scale
+}
+
+// IsMoney checks if the string is an amount e.g. -12.34 with at most as many fraction digits as the minor units
+// of the ISO 4217 currency e.g. 0 for JPY, 2 for USD and 3 for BHD. Currencies without minor units (e.g. XAU)
+// allow any number of fraction digits.
+func IsMoney(str, currency string) bool {
+	entry, ok := iso4217ByCode()[strings.ToUpper(currency)]
+	if !ok {
+		return false
+	}
+	_, fraction, ok := decimalDigits(str)
+	return ok && (entry.MinorUnits < 0 || fraction <= entry.MinorUnits)
+}
+
+func isDecimalRaw(str string, params ...string) bool {
+	if len(params) == 2 {
+		precision, _ := ToInt(params[0])
+		scale, _ := ToInt(params[1])
+		return IsDecimal(str, int(precision), int(scale))
+	}
+	return false
+}
+
+func isMoneyRaw(str string, params ...string) bool {
+	if len(params) == 1 {
+		return IsMoney(str, params[0])
+	}
+	return false
+}
@@ -0,0 +1,127 @@
+package govalidator
+
+import "testing"
+
+func TestLookupISO4217(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected ISO4217Entry
+	}{
+		{"USD", ISO4217Entry{"USD", "840", 2}},
+		{"JPY", ISO4217Entry{"JPY", "392", 0}},
+		{"BHD", ISO4217Entry{"BHD", "048", 3}},
+		{"CLF", ISO4217Entry{"CLF", "990", 4}},
+		{"XAU", ISO4217Entry{"XAU", "959", -1}},
+	}
+	for _, test := range tests {
+		actual, ok := LookupISO4217(test.param)
+		if !ok || actual != test.expected {
+			t.Errorf("Expected LookupISO4217(%q) to be %+v, got %+v", test.param, test.expected, actual)
+		}
+	}
+	if _, ok := LookupISO4217("usd"); ok {
+		t.Error("Expected LookupISO4217(\"usd\") to fail")
+	}
+}
+
+func TestIsMoney(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		currency string
+		expected bool
+	}{
+		{"12.34", "USD", true},
+		{"-12.34", "USD", true},
+		{"12", "USD", true},
+		{"12.3", "usd", true},
+		{"12.345", "USD", false},
+		{"1000", "JPY", true},
+		{"1000.5", "JPY", false},
+		{"1.234", "BHD", true},
+		{"1.2345", "BHD", false},
+		{"0.1234", "CLF", true},
+		{"1.23456789", "XAU", true},
+		{"12.34", "ZZZ", false},
+		{"", "USD", false},
+		{"12.", "USD", false},
+		{".5", "USD", false},
+		{"1,000.00", "USD", false},
+		{"$12", "USD", false},
+		{"--12", "USD", false},
+	}
+	for _, test := range tests {
+		actual := IsMoney(test.param, test.currency)
+		if actual != test.expected {
+			t.Errorf("Expected IsMoney(%q, %q) to be %v, got %v", test.param, test.currency, test.expected, actual)
+		}
+	}
+}
+
+func TestIsDecimal(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param     string
+		precision int
+		scale     int
+		expected  bool
+	}{
+		{"999.99", 5, 2, true},
+		{"-999.99", 5, 2, true},
+		{"+0.5", 5, 2, true},
+		{"000123.4", 5, 2, true},
+		{"0", 5, 2, true},
+		{"1000.00", 5, 2, false},
+		{"9.999", 5, 2, false},
+		{"12345", 5, 0, true},
+		{"123456", 5, 0, false},
+		{"1e+21", 22, 0, true},
+		{"1e+21", 21, 0, false},
+		{"1.5e-3", 5, 4, true},
+		{"1.5e-3", 5, 3, false},
+		{"1.5e3", 4, 0, true},
+		{"1e", 5, 2, false},
+		{"abc", 5, 2, false},
+		{"1.2.3", 5, 2, false},
+		{"", 5, 2, false},
+	}
+	for _, test := range tests {
+		actual := IsDecimal(test.param, test.precision, test.scale)
+		if actual != test.expected {
+			t.Errorf("Expected IsDecimal(%q, %d, %d) to be %v, got %v", test.param, test.precision, test.scale, test.expected, actual)
+		}
+	}
+}
+
+func TestValidateMoney(t *testing.T) {
+	type Payment struct {
+		Amount   string  `valid:"money_iso4217_field(Currency)"`
+		Currency string  `valid:"ISO4217"`
+		Fee      float64 `valid:"money(USD)"`
+		Rate     float64 `valid:"decimal(5|4)"`
+		Quantity int     `valid:"decimal(3|0)"`
+	}
+
+	var tests = []struct {
+		param    Payment
+		expected bool
+	}{
+		{Payment{"1500", "JPY", 0.25, 1.2345, 999}, true},
+		{Payment{"12.345", "BHD", 10, 0.5, 1}, true},
+		{Payment{"1500.5", "JPY", 0.25, 1.2345, 999}, false},
+		{Payment{"12.34", "ZZZ", 0.25, 1.2345, 999}, false},
+		{Payment{"12.34", "USD", 0.125, 1.2345, 999}, false},
+		{Payment{"12.34", "USD", 0.25, 12.345, 999}, false},
+		{Payment{"12.34", "USD", 0.25, 1.2345, 1000}, false},
+	}
+	for _, test := range tests {
+		actual, err := Validate(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected Validate(%+v) to be %v, got %v %v", test.param, test.expected, actual, err)
+		}
+	}
+}
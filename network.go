@@ -0,0 +1,126 @@
+package govalidator
+
+import (
+	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+// parseAddr parses an IP address without its IPv6 zone, unmapping IPv4-mapped IPv6 addresses e.g. ::ffff:10.0.0.1.
+func parseAddr(str string) (netip.Addr, bool) {
+	addr, err := netip.ParseAddr(str)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return addr.WithZone("").Unmap(), true
+}
+
+// IsIPIn checks if the string is an IP address within one of the CIDR prefixes e.g. 10.0.0.0/8.
+func IsIPIn(str string, prefixes ...string) bool {
+	addr, ok := parseAddr(str)
+	if !ok {
+		return false
+	}
+	for _, p := range prefixes {
+		prefix, err := netip.ParsePrefix(strings.TrimSpace(p))
+		if err == nil && prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsPublicIP checks if the string is a publicly routable IP address i.e. not loopback,
+// private (RFC 1918 and RFC 4193), link-local, multicast, unspecified, carrier-grade NAT or otherwise reserved.
+// NAT64 and 6to4 addresses are checked by the IPv4 address they embed.
+func IsPublicIP(str string) bool {
+	addr, ok := parseAddr(str)
+	return ok && isPublicAddr(addr)
+}
+
+// IsPrivateIP checks if the string is a private IP address i.e. in 10.0.0.0/8, 172.16.0.0/12, 192.168.0.0/16 or fc00::/7.
+func IsPrivateIP(str string) bool {
+	addr, ok := parseAddr(str)
+	return ok && addr.IsPrivate()
+}
+
+// IsLoopbackIP checks if the string is a loopback IP address i.e. in 127.0.0.0/8 or ::1.
+func IsLoopbackIP(str string) bool {
+	addr, ok := parseAddr(str)
+	return ok && addr.IsLoopback()
+}
+
+// IsCIDRWithin checks if the string is a CIDR prefix contained by one of the parent prefixes
+// e.g. 10.1.0.0/16 is within 10.0.0.0/8 but 10.0.0.0/7 isn't.
+func IsCIDRWithin(str string, parents ...string) bool {
+	prefix, err := netip.ParsePrefix(str)
+	if err != nil {
+		return false
+	}
+	prefix = prefix.Masked()
+	for _, p := range parents {
+		parent, err := netip.ParsePrefix(strings.TrimSpace(p))
+		if err == nil && parent.Bits() <= prefix.Bits() && parent.Contains(prefix.Addr()) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsCIDRMaxPrefix checks if the string is a CIDR prefix whose length is at most bits
+// e.g. 10.0.0.0/16 is valid for 24 but 10.0.0.0/28 isn't.
+func IsCIDRMaxPrefix(str string, bits int) bool {
+	prefix, err := netip.ParsePrefix(str)
+	return err == nil && prefix.Bits() <= bits
+}
+
+// IsPortRange checks if the string is a range of ports e.g. 8000-8080, the first port being at most the last.
+func IsPortRange(str string) bool {
+	i := strings.IndexByte(str, '-')
+	if i < 0 || !IsPort(str[:i]) || !IsPort(str[i+1:]) {
+		return false
+	}
+	from, _ := strconv.Atoi(str[:i])
+	to, _ := strconv.Atoi(str[i+1:])
+	return from <= to
+}
+
+// IsHostPort checks if the string is a host (DNS name or IP address) and port e.g. example.com:443 or [::1]:8080.
+// IPv6 addresses must be in brackets, which are only allowed around IPv6 addresses.
+func IsHostPort(str string) bool {
+	host, port, err := net.SplitHostPort(str)
+	if err != nil || host == "" || !IsPort(port) {
+		return false
+	}
+	if strings.HasPrefix(str, "[") {
+		addr, err := netip.ParseAddr(host)
+		return err == nil && addr.Is6()
+	}
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return addr.Is4()
+	}
+	return IsDNSName(host)
+}
+
+func isIPInRaw(str string, params ...string) bool {
+	if len(params) == 1 {
+		return IsIPIn(str, strings.Split(params[0], "|")...)
+	}
+	return false
+}
+
+func isCIDRWithinRaw(str string, params ...string) bool {
+	if len(params) == 1 {
+		return IsCIDRWithin(str, strings.Split(params[0], "|")...)
+	}
+	return false
+}
+
+func isCIDRMaxPrefixRaw(str string, params ...string) bool {
+	if len(params) == 1 {
+		bits, err := strconv.Atoi(params[0])
+		return err == nil && IsCIDRMaxPrefix(str, bits)
+	}
+	return false
+}
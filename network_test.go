@@ -0,0 +1,196 @@
+package govalidator
+
+import "testing"
+
+func TestIsIPIn(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		prefixes []string
+		expected bool
+	}{
+		{"10.1.2.3", []string{"10.0.0.0/8"}, true},
+		{"192.168.1.1", []string{"10.0.0.0/8", "192.168.0.0/16"}, true},
+		{"172.16.0.1", []string{"10.0.0.0/8", "192.168.0.0/16"}, false},
+		{"::ffff:10.1.2.3", []string{"10.0.0.0/8"}, true},
+		{"fe80::1%eth0", []string{"fe80::/10"}, true},
+		{"2001:db8::1", []string{"2001:db8::/32"}, true},
+		{"2001:db9::1", []string{"2001:db8::/32"}, false},
+		{"10.1.2.3", []string{"invalid"}, false},
+		{"10.1.2", []string{"10.0.0.0/8"}, false},
+		{"", []string{"0.0.0.0/0"}, false},
+	}
+	for _, test := range tests {
+		actual := IsIPIn(test.param, test.prefixes...)
+		if actual != test.expected {
+			t.Errorf("Expected IsIPIn(%q, %v) to be %v, got %v", test.param, test.prefixes, test.expected, actual)
+		}
+	}
+}
+
+func TestIPClasses(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		public   bool
+		private  bool
+		loopback bool
+	}{
+		{"8.8.8.8", true, false, false},
+		{"2606:4700:4700::1111", true, false, false},
+		{"10.0.0.1", false, true, false},
+		{"172.31.255.255", false, true, false},
+		{"192.168.0.1", false, true, false},
+		{"fd00::1", false, true, false},
+		{"127.0.0.1", false, false, true},
+		{"::1", false, false, true},
+		{"::ffff:127.0.0.1", false, false, true},
+		{"64:ff9b::a00:1", false, false, false},
+		{"2002:808:808::1", true, false, false},
+		{"100.64.0.1", false, false, false},
+		{"169.254.1.1", false, false, false},
+		{"0.0.0.0", false, false, false},
+		{"224.0.0.1", false, false, false},
+		{"example.com", false, false, false},
+		{"", false, false, false},
+	}
+	for _, test := range tests {
+		if actual := IsPublicIP(test.param); actual != test.public {
+			t.Errorf("Expected IsPublicIP(%q) to be %v, got %v", test.param, test.public, actual)
+		}
+		if actual := IsPrivateIP(test.param); actual != test.private {
+			t.Errorf("Expected IsPrivateIP(%q) to be %v, got %v", test.param, test.private, actual)
+		}
+		if actual := IsLoopbackIP(test.param); actual != test.loopback {
+			t.Errorf("Expected IsLoopbackIP(%q) to be %v, got %v", test.param, test.loopback, actual)
+		}
+	}
+}
+
+func TestIsCIDRWithin(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		parents  []string
+		expected bool
+	}{
+		{"10.1.0.0/16", []string{"10.0.0.0/8"}, true},
+		{"10.0.0.0/8", []string{"10.0.0.0/8"}, true},
+		{"10.1.2.3/24", []string{"10.0.0.0/8"}, true},
+		{"10.0.0.0/7", []string{"10.0.0.0/8"}, false},
+		{"11.0.0.0/16", []string{"10.0.0.0/8"}, false},
+		{"192.168.4.0/24", []string{"10.0.0.0/8", "192.168.0.0/16"}, true},
+		{"2001:db8:1::/48", []string{"2001:db8::/32"}, true},
+		{"2001:db8::/32", []string{"10.0.0.0/8"}, false},
+		{"10.1.0.0", []string{"10.0.0.0/8"}, false},
+		{"10.1.0.0/16", []string{"10.0.0.0"}, false},
+	}
+	for _, test := range tests {
+		actual := IsCIDRWithin(test.param, test.parents...)
+		if actual != test.expected {
+			t.Errorf("Expected IsCIDRWithin(%q, %v) to be %v, got %v", test.param, test.parents, test.expected, actual)
+		}
+	}
+}
+
+func TestIsCIDRMaxPrefix(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		bits     int
+		expected bool
+	}{
+		{"10.0.0.0/16", 24, true},
+		{"10.0.0.0/24", 24, true},
+		{"10.0.0.0/28", 24, false},
+		{"2001:db8::/48", 64, true},
+		{"2001:db8::/128", 64, false},
+		{"10.0.0.0", 24, false},
+	}
+	for _, test := range tests {
+		actual := IsCIDRMaxPrefix(test.param, test.bits)
+		if actual != test.expected {
+			t.Errorf("Expected IsCIDRMaxPrefix(%q, %d) to be %v, got %v", test.param, test.bits, test.expected, actual)
+		}
+	}
+}
+
+func TestIsPortRange(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"8000-8080", true},
+		{"80-80", true},
+		{"1-65535", true},
+		{"8080-8000", false},
+		{"0-80", false},
+		{"80-65536", false},
+		{"8000", false},
+		{"8000-", false},
+		{"-8080", false},
+		{"8000-8080-9000", false},
+		{"a-b", false},
+	}
+	for _, test := range tests {
+		actual := IsPortRange(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsPortRange(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestIsHostPort(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"example.com:443", true},
+		{"localhost:8080", true},
+		{"127.0.0.1:80", true},
+		{"[::1]:8080", true},
+		{"[2001:db8::1]:443", true},
+		{"[fe80::1%eth0]:443", true},
+		{"::1:8080", false},
+		{"[127.0.0.1]:80", false},
+		{"[example.com]:80", false},
+		{"example.com", false},
+		{"example.com:", false},
+		{"example.com:0", false},
+		{"example.com:65536", false},
+		{":80", false},
+		{"exa mple.com:80", false},
+	}
+	for _, test := range tests {
+		actual := IsHostPort(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsHostPort(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestValidateNetwork(t *testing.T) {
+	type Rule struct {
+		Source  string `valid:"ip_in(10.0.0.0/8|192.168.0.0/16)"`
+		Subnet  string `valid:"cidr_within(10.0.0.0/8),cidr_maxprefix(24)"`
+		Ports   string `valid:"port_range"`
+		Backend string `valid:"hostport"`
+	}
+
+	if ok, err := Validate(Rule{"192.168.1.10", "10.20.0.0/16", "8000-8080", "[::1]:9000"}); !ok {
+		t.Errorf("Expected rule to pass, got %v", err)
+	}
+	if ok, err := Validate(Rule{"8.8.8.8", "10.20.0.0/28", "8080-8000", "::1:9000"}); ok {
+		t.Errorf("Expected rule to fail, got %v", err)
+	}
+}
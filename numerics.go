@@ -2,6 +2,7 @@ package govalidator
 
 import (
 	"math"
+	"math/big"
 	"reflect"
 )
 
@@ -68,7 +69,15 @@ func InRangeFloat64(value, left, right float64) bool {
 	return value >= left && value <= right
 }
 
-// InRange returns true if value lies between left and right border, generic type to handle int, float32 or float64, all types must the same type
+// InRangeUint returns true if value lies between left and right border
+func InRangeUint(value, left, right uint64) bool {
+	if left > right {
+		left, right = right, left
+	}
+	return value >= left && value <= right
+}
+
+// InRange returns true if value lies between left and right border, generic type to handle int, int64, uint64, float32 or float64, all types must the same type
 func InRange(value interface{}, left interface{}, right interface{}) bool {
 
 	reflectValue := reflect.TypeOf(value).Kind()
@@ -77,6 +86,10 @@ func InRange(value interface{}, left interface{}, right interface{}) bool {
 
 	if reflectValue == reflect.Int && reflectLeft == reflect.Int && reflectRight == reflect.Int {
 		return InRangeInt(value.(int), left.(int), right.(int))
+	} else if reflectValue == reflect.Int64 && reflectLeft == reflect.Int64 && reflectRight == reflect.Int64 {
+		return InRangeInt(value.(int64), left.(int64), right.(int64))
+	} else if reflectValue == reflect.Uint64 && reflectLeft == reflect.Uint64 && reflectRight == reflect.Uint64 {
+		return InRangeUint(value.(uint64), left.(uint64), right.(uint64))
 	} else if reflectValue == reflect.Float32 && reflectLeft == reflect.Float32 && reflectRight == reflect.Float32 {
 		return InRangeFloat32(value.(float32), left.(float32), right.(float32))
 	} else if reflectValue == reflect.Float64 && reflectLeft == reflect.Float64 && reflectRight == reflect.Float64 {
@@ -95,3 +108,102 @@ func IsWhole(value float64) bool {
 func IsNatural(value float64) bool {
 	return IsWhole(value) && IsPositive(value)
 }
+
+// CompareNumbers compares two numbers of any int, uint or float kind without
+// first converting them to a common (lossy) type. It returns -1, 0 or +1 when
+// a is less than, equal to or greater than b, and false when either of them
+// isn't a number or is NaN.
+func CompareNumbers(a, b interface{}) (int, bool) {
+	x, ok := reflectNumber(a)
+	if !ok {
+		return 0, false
+	}
+	y, ok := reflectNumber(b)
+	if !ok {
+		return 0, false
+	}
+
+	switch {
+	case isIntKind(x.Kind()) && isIntKind(y.Kind()):
+		return compareInt64(x.Int(), y.Int()), true
+	case isUintKind(x.Kind()) && isUintKind(y.Kind()):
+		return compareUint64(x.Uint(), y.Uint()), true
+	case isIntKind(x.Kind()) && isUintKind(y.Kind()):
+		if x.Int() < 0 {
+			return -1, true
+		}
+		return compareUint64(uint64(x.Int()), y.Uint()), true
+	case isUintKind(x.Kind()) && isIntKind(y.Kind()):
+		if y.Int() < 0 {
+			return 1, true
+		}
+		return compareUint64(x.Uint(), uint64(y.Int())), true
+	}
+
+	// At least one float, compare exactly using big.Float.
+	fx, ok := bigFloat(x)
+	if !ok {
+		return 0, false
+	}
+	fy, ok := bigFloat(y)
+	if !ok {
+		return 0, false
+	}
+	return fx.Cmp(fy), true
+}
+
+func reflectNumber(i interface{}) (reflect.Value, bool) {
+	v := reflect.ValueOf(i)
+	if !v.IsValid() {
+		return v, false
+	}
+	k := v.Kind()
+	return v, isIntKind(k) || isUintKind(k) || k == reflect.Float32 || k == reflect.Float64
+}
+
+func isIntKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	}
+	return false
+}
+
+func isUintKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+	return false
+}
+
+func bigFloat(v reflect.Value) (*big.Float, bool) {
+	switch {
+	case isIntKind(v.Kind()):
+		return new(big.Float).SetInt64(v.Int()), true
+	case isUintKind(v.Kind()):
+		return new(big.Float).SetUint64(v.Uint()), true
+	}
+	if math.IsNaN(v.Float()) {
+		return nil, false
+	}
+	return new(big.Float).SetFloat64(v.Float()), true
+}
+
+func compareInt64(a, b int64) int {
+	if a < b {
+		return -1
+	} else if a > b {
+		return 1
+	}
+	return 0
+}
+
+func compareUint64(a, b uint64) int {
+	if a < b {
+		return -1
+	} else if a > b {
+		return 1
+	}
+	return 0
+}
@@ -1,6 +1,9 @@
 package govalidator
 
-import "testing"
+import (
+	"math"
+	"testing"
+)
 
 func TestAbs(t *testing.T) {
 	t.Parallel()
@@ -547,3 +550,56 @@ func TestInRange(t *testing.T) {
 		}
 	}
 }
+
+func TestInRangeUint(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    uint64
+		left     uint64
+		right    uint64
+		expected bool
+	}{
+		{0, 0, 0, true},
+		{1, 0, 0, false},
+		{0, 0, 1, true},
+		{5, 10, 1, true},
+		{18446744073709551615, 0, 18446744073709551615, true},
+		{18446744073709551615, 0, 18446744073709551614, false},
+	}
+	for _, test := range tests {
+		actual := InRangeUint(test.param, test.left, test.right)
+		if actual != test.expected {
+			t.Errorf("Expected InRangeUint(%v, %v, %v) to be %v, got %v", test.param, test.left, test.right, test.expected, actual)
+		}
+	}
+}
+
+func TestCompareNumbers(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		a        interface{}
+		b        interface{}
+		expected int
+		ok       bool
+	}{
+		{1, 1, 0, true},
+		{int8(-1), uint(1), -1, true},
+		{uint64(18446744073709551615), int64(9223372036854775807), 1, true},
+		{int64(9223372036854775807), uint64(9223372036854775807), 0, true},
+		{int64(9007199254740993), float64(9007199254740992), 1, true},
+		{float32(0.5), 0.5, 0, true},
+		{-0.5, 0, -1, true},
+		{uint8(10), 9.99, 1, true},
+		{math.NaN(), 1, 0, false},
+		{"1", 1, 0, false},
+		{nil, 1, 0, false},
+	}
+	for _, test := range tests {
+		actual, ok := CompareNumbers(test.a, test.b)
+		if actual != test.expected || ok != test.ok {
+			t.Errorf("Expected CompareNumbers(%v, %v) to be %v, %v, got %v, %v", test.a, test.b, test.expected, test.ok, actual, ok)
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package govalidator
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// PasswordPolicy is a set of password rules, zero values disable a rule.
+// MaxRepeat is the most times a character may be repeated in a row (e.g. 2 rejects "aaa"),
+// MaxSequence is the longest allowed run of consecutive characters (e.g. 3 rejects "abcd" and "4321"),
+// Forbidden are case insensitive substrings such as the product name and MinEntropy is in bits (see PasswordEntropy).
+// Register a policy for the `password(name)` tag with:
+//
+//	govalidator.PasswordPolicyMap["admin"] = &govalidator.PasswordPolicy{MinLength: 12, RequireSymbol: true}
+type PasswordPolicy struct {
+	MinLength     int
+	MaxLength     int
+	RequireLower  bool
+	RequireUpper  bool
+	RequireDigit  bool
+	RequireSymbol bool
+	MaxRepeat     int
+	MaxSequence   int
+	Forbidden     []string
+	MinEntropy    float64
+	RejectCommon  bool
+}
+
+// PasswordPolicyMap maps the names used by the `password(name)` tag to their policies.
+// The "default" policy follows NIST SP 800-63B: 8 to 64 characters that aren't a common password.
+var PasswordPolicyMap = map[string]*PasswordPolicy{
+	"default": {MinLength: 8, MaxLength: 64, RejectCommon: true},
+	"strong": {
+		MinLength: 12, MaxLength: 128,
+		RequireLower: true, RequireUpper: true, RequireDigit: true, RequireSymbol: true,
+		MaxRepeat: 2, MaxSequence: 3, MinEntropy: 60, RejectCommon: true,
+	},
+}
+
+// Validate returns the first rule the password breaks or nil if it follows the policy.
+// The error never includes the password itself.
+func (p *PasswordPolicy) Validate(password string) error {
+	length := utf8.RuneCountInString(password)
+	switch {
+	case p.MinLength > 0 && length < p.MinLength:
+		return fmt.Errorf("password must be at least %d characters", p.MinLength)
+	case p.MaxLength > 0 && length > p.MaxLength:
+		return fmt.Errorf("password must be at most %d characters", p.MaxLength)
+	case p.RequireLower && !HasLowerCase(password):
+		return fmt.Errorf("password must contain a lowercase letter")
+	case p.RequireUpper && !HasUpperCase(password):
+		return fmt.Errorf("password must contain an uppercase letter")
+	case p.RequireDigit && strings.IndexFunc(password, unicode.IsDigit) < 0:
+		return fmt.Errorf("password must contain a digit")
+	case p.RequireSymbol && strings.IndexFunc(password, isPasswordSymbol) < 0:
+		return fmt.Errorf("password must contain a symbol")
+	case p.MaxRepeat > 0 && longestRun(password, 0) > p.MaxRepeat:
+		return fmt.Errorf("password must not repeat a character more than %d times in a row", p.MaxRepeat)
+	case p.MaxSequence > 0 && (longestRun(password, 1) > p.MaxSequence || longestRun(password, -1) > p.MaxSequence):
+		return fmt.Errorf("password must not contain more than %d consecutive characters such as abcd or 4321", p.MaxSequence)
+	}
+	lower := strings.ToLower(password)
+	for _, forbidden := range p.Forbidden {
+		if forbidden != "" && strings.Contains(lower, strings.ToLower(forbidden)) {
+			return fmt.Errorf("password must not contain %q", forbidden)
+		}
+	}
+	if p.RejectCommon && IsCommonPassword(password) {
+		return fmt.Errorf("password is too common")
+	}
+	if p.MinEntropy > 0 && PasswordEntropy(password) < p.MinEntropy {
+		return fmt.Errorf("password must have at least %.0f bits of entropy", p.MinEntropy)
+	}
+	return nil
+}
+
+// PasswordEntropy returns an estimate of the strength of a password in bits, being its length
+// multiplied by log2 of the size of the character classes it uses (lowercase, uppercase, digits,
+// symbols and other Unicode characters). It doesn't account for words or patterns.
+func PasswordEntropy(password string) float64 {
+	var lower, upper, digit, symbol, other bool
+	for _, r := range password {
+		switch {
+		case 'a' <= r && r <= 'z':
+			lower = true
+		case 'A' <= r && r <= 'Z':
+			upper = true
+		case '0' <= r && r <= '9':
+			digit = true
+		case r < utf8.RuneSelf:
+			symbol = true
+		default:
+			other = true
+		}
+	}
+	pool := 0
+	for _, class := range []struct {
+		used bool
+		size int
+	}{{lower, 26}, {upper, 26}, {digit, 10}, {symbol, 33}, {other, 100}} {
+		if class.used {
+			pool += class.size
+		}
+	}
+	if pool == 0 {
+		return 0
+	}
+	return float64(utf8.RuneCountInString(password)) * math.Log2(float64(pool))
+}
+
+// IsCommonPassword checks if the password (case insensitive) is in the embedded list of common passwords.
+func IsCommonPassword(password string) bool {
+	_, ok := commonPasswords[strings.ToLower(password)]
+	return ok
+}
+
+func isPasswordSymbol(r rune) bool {
+	return unicode.IsPunct(r) || unicode.IsSymbol(r)
+}
+
+// longestRun returns the length of the longest run of characters each step apart
+// e.g. step 0 counts repeats (aaa), 1 ascending (abc) and -1 descending (cba) sequences.
+func longestRun(str string, step rune) int {
+	longest, run := 0, 0
+	var prev rune
+	for i, r := range str {
+		if i > 0 && r-prev == step && (step == 0 || unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			run++
+		} else {
+			run = 1
+		}
+		if run > longest {
+			longest = run
+		}
+		prev = r
+	}
+	return longest
+}
+
+func validatePasswordRaw(str string, params ...string) error {
+	if len(params) != 1 {
+		return fmt.Errorf("password policy required")
+	}
+	policy, ok := PasswordPolicyMap[params[0]]
+	if !ok {
+		return fmt.Errorf("unknown password policy %s", params[0])
+	}
+	return policy.Validate(str)
+}
+
+// commonPasswords are the most common passwords found in public data breaches.
+var commonPasswords = func() map[string]struct{} {
+	passwords := make(map[string]struct{})
+	for _, password := range strings.Fields(`
+		123456 password 12345678 qwerty 123456789 12345 1234 111111 1234567 dragon
+		123123 baseball abc123 football monkey letmein 696969 shadow master 666666
+		qwertyuiop 123321 mustang 1234567890 michael 654321 superman 1qaz2wsx 7777777 121212
+		000000 qazwsx 123qwe killer trustno1 jordan jennifer zxcvbnm asdfgh hunter
+		buster soccer harley batman andrew tigger sunshine iloveyou 2000 charlie
+		robert thomas hockey ranger daniel starwars klaster 112233 george computer
+		michelle jessica pepper 1111 zxcvbn 555555 11111111 131313 freedom 777777
+		pass maggie 159753 aaaaaa ginger princess joshua cheese amanda summer
+		love ashley nicole chelsea biteme matthew access yankees 987654321 dallas
+		austin thunder taylor matrix montana
+		password1 password12 password123 passw0rd p@ssw0rd p@ssword admin admin123 administrator root
+		toor welcome welcome1 welcome123 login guest qwerty123 qwerty1 1q2w3e4r 1q2w3e4r5t
+		1q2w3e 123abc abcd1234 a123456 123456a 12345678910 0987654321 asdfghjkl zaq12wsx changeme
+		secret letmein1 iloveyou1 princess1 football1 monkey1 sunshine1 shadow1 master1 dragon1
+		baseball1 superman1 whatever starwars1 hello hello123 test test123 testing
+		default qwe123 q1w2e3r4 q1w2e3r4t5 azerty 123654 147258369 11223344 987654 159357
+		samsung google apple linkedin facebook twitter myspace1 flower lovely 12341234
+		aa123456 iloveu 123qweasd qwertyui 88888888 1234qwer asdf1234 zxcvbnm1 qweasdzxc
+	`) {
+		passwords[strings.ToLower(password)] = struct{}{}
+	}
+	return passwords
+}()
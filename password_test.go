@@ -0,0 +1,113 @@
+package govalidator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPasswordPolicy(t *testing.T) {
+	t.Parallel()
+
+	policy := &PasswordPolicy{
+		MinLength: 8, MaxLength: 20,
+		RequireLower: true, RequireUpper: true, RequireDigit: true, RequireSymbol: true,
+		MaxRepeat: 2, MaxSequence: 3, Forbidden: []string{"acme"}, MinEntropy: 55, RejectCommon: true,
+	}
+	var tests = []struct {
+		param    string
+		expected string
+	}{
+		{"Tr0ub4dor&3x", ""},
+		{"Sh0rt!", "password must be at least 8 characters"},
+		{"Wa4y!" + strings.Repeat("x1", 10), "password must be at most 20 characters"},
+		{"TR0UB4DOR&3X", "password must contain a lowercase letter"},
+		{"tr0ub4dor&3x", "password must contain an uppercase letter"},
+		{"Troubador&xx", "password must contain a digit"},
+		{"Tr0ub4dor33x", "password must contain a symbol"},
+		{"Tr0ub4dooor&3", "password must not repeat a character more than 2 times in a row"},
+		{"Tr0ub4dor&1234", "password must not contain more than 3 consecutive characters such as abcd or 4321"},
+		{"Tr0ub4dor&dcba", "password must not contain more than 3 consecutive characters such as abcd or 4321"},
+		{"Tr0ub4-ACME-&3", `password must not contain "acme"`},
+		{"Aa1!Aa1!", "password must have at least 55 bits of entropy"},
+	}
+	for _, test := range tests {
+		actual := ""
+		if err := policy.Validate(test.param); err != nil {
+			actual = err.Error()
+		}
+		if actual != test.expected {
+			t.Errorf("Expected PasswordPolicy.Validate(%q) to be %q, got %q", test.param, test.expected, actual)
+		}
+	}
+
+	common := &PasswordPolicy{RejectCommon: true}
+	if err := common.Validate("P@ssw0rd"); err == nil || err.Error() != "password is too common" {
+		t.Errorf("Expected PasswordPolicy.Validate(%q) to be %q, got %v", "P@ssw0rd", "password is too common", err)
+	}
+}
+
+func TestPasswordEntropy(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected float64
+	}{
+		{"", 0},
+		{"aaaa", 4 * 4.700439718141092},
+		{"aA1!", 4 * 6.569855608330948},
+	}
+	for _, test := range tests {
+		actual := PasswordEntropy(test.param)
+		if actual < test.expected-0.0001 || actual > test.expected+0.0001 {
+			t.Errorf("Expected PasswordEntropy(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestIsCommonPassword(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"123456", true},
+		{"PASSWORD", true},
+		{"qwerty123", true},
+		{"correct horse battery staple", false},
+	}
+	for _, test := range tests {
+		actual := IsCommonPassword(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsCommonPassword(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestValidatePassword(t *testing.T) {
+	type Signup struct {
+		Password string `json:"password" valid:"password(default)"`
+		Admin    string `json:"admin" valid:"password(strong),optional"`
+		Other    string `json:"other" valid:"password(missing),optional"`
+	}
+
+	if ok, err := Validate(Signup{Password: "correct horse battery"}); !ok {
+		t.Errorf("Expected password(default) to pass, got %v", err)
+	}
+
+	ok, errs := Validate(Signup{Password: "letmein", Admin: "password", Other: "x"})
+	if ok {
+		t.Fatal("Expected password tags to fail")
+	}
+	expected := map[string]string{
+		"password": "password must be at least 8 characters",
+		"admin":    "password must be at least 12 characters",
+		"other":    "unknown password policy missing",
+	}
+	for field, message := range expected {
+		if actual := errs["errors"][field]; len(actual) != 1 || actual[0] != message {
+			t.Errorf("Expected %s error to be %q, got %v", field, message, actual)
+		}
+	}
+}
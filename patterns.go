@@ -5,13 +5,17 @@ import "regexp"
 // Basic regular expressions for validating strings
 const (
 	Email          string = "^(((([a-zA-Z]|\\d|[!#\\$%&'\\*\\+\\-\\/=\\?\\^_`{\\|}~]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])+(\\.([a-zA-Z]|\\d|[!#\\$%&'\\*\\+\\-\\/=\\?\\^_`{\\|}~]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])+)*)|((\\x22)((((\\x20|\\x09)*(\\x0d\\x0a))?(\\x20|\\x09)+)?(([\\x01-\\x08\\x0b\\x0c\\x0e-\\x1f\\x7f]|\\x21|[\\x23-\\x5b]|[\\x5d-\\x7e]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(\\([\\x01-\\x09\\x0b\\x0c\\x0d-\\x7f]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}]))))*(((\\x20|\\x09)*(\\x0d\\x0a))?(\\x20|\\x09)+)?(\\x22)))@((([a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(([a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])([a-zA-Z]|\\d|-|\\.|_|~|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])*([a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])))\\.)+(([a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(([a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])([a-zA-Z]|\\d|-|_|~|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])*([a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])))\\.?$"
-	CreditCard     string = "^(?:4[0-9]{12}(?:[0-9]{3})?|5[1-5][0-9]{14}|6(?:011|5[0-9][0-9])[0-9]{12}|3[47][0-9]{13}|3(?:0[0-5]|[68][0-9])[0-9]{11}|(?:2131|1800|35\\d{3})\\d{11})$"
 	ISBN10         string = "^(?:[0-9]{9}X|[0-9]{10})$"
 	ISBN13         string = "^(?:[0-9]{13})$"
 	UUID3          string = "^[0-9a-f]{8}-[0-9a-f]{4}-3[0-9a-f]{3}-[0-9a-f]{4}-[0-9a-f]{12}$"
 	UUID4          string = "^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$"
 	UUID5          string = "^[0-9a-f]{8}-[0-9a-f]{4}-5[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$"
+	UUID6          string = "^[0-9a-f]{8}-[0-9a-f]{4}-6[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$"
+	UUID7          string = "^[0-9a-f]{8}-[0-9a-f]{4}-7[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$"
+	UUID8          string = "^[0-9a-f]{8}-[0-9a-f]{4}-8[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$"
 	UUID           string = "^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$"
+	ULID           string = "^[0-7][0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{25}$"
+	KSUID          string = "^[0-9A-Za-z]{27}$"
 	Alpha          string = "^[a-zA-Z]+$"
 	Alphanumeric   string = "^[a-zA-Z0-9]+$"
 	Numeric        string = "^[0-9]+$"
@@ -42,11 +46,29 @@ const (
 	WinPath        string = `^[a-zA-Z]:\\(?:[^\\/:*?"<>|\r\n]+\\)*[^\\/:*?"<>|\r\n]*$`
 	UnixPath       string = `^(/[^/\x00]*)+/?$`
 	Semver         string = "^v?(?:0|[1-9]\\d*)\\.(?:0|[1-9]\\d*)\\.(?:0|[1-9]\\d*)(-(0|[1-9]\\d*|\\d*[a-zA-Z-][0-9a-zA-Z-]*)(\\.(0|[1-9]\\d*|\\d*[a-zA-Z-][0-9a-zA-Z-]*))*)?(\\+[0-9a-zA-Z-]+(\\.[0-9a-zA-Z-]+)*)?$"
+	E164           string = `^\+[1-9]\d{1,14}$`
+	IBAN           string = `^[A-Z]{2}\d{2}[A-Z0-9]{1,30}$`
+	BIC            string = `^[A-Z]{4}[A-Z]{2}[A-Z0-9]{2}(?:[A-Z0-9]{3})?$`
+	ABARouting     string = `^(?:0\d|1[0-2]|2[1-9]|3[0-2]|6[1-9]|7[0-2]|80)\d{7}$`
+	UKSortCode     string = `^(?:\d{2}-\d{2}-\d{2}|\d{2} \d{2} \d{2}|\d{6})$`
+	NINO           string = `^[A-CEGHJ-PR-TW-Z][A-CEGHJ-NPR-TW-Z]\d{6}[A-D]$`
+	PAN            string = `^[A-Z]{3}[ABCFGHJLPT][A-Z]\d{4}[A-Z]$`
+	DNI            string = `^\d{8}[A-Z]$`
+	NIE            string = `^[XYZ]\d{7}[A-Z]$`
+	CVV            string = `^\d{3,4}$`
+	CardExpiry     string = `^(0[1-9]|1[0-2]) ?/ ?(\d{2}|\d{4})$`
 	tagName        string = "valid"
+	numberParam    string = `([-+]?(?:\d+(?:\.\d*)?|\.\d+)(?:[eE][-+]?\d+)?)`
 	hasLowerCase   string = ".*[[:lower:]]"
 	hasUpperCase   string = ".*[[:upper:]]"
 )
 
+// CreditCard is the former pattern of credit card numbers.
+//
+// Deprecated: it isn't used by IsCreditCard and misses current card ranges e.g. Mastercard's 2-series,
+// use IsCreditCard or CardBrand instead.
+const CreditCard string = "^(?:4[0-9]{12}(?:[0-9]{3})?|5[1-5][0-9]{14}|6(?:011|5[0-9][0-9])[0-9]{12}|3[47][0-9]{13}|3(?:0[0-5]|[68][0-9])[0-9]{11}|(?:2131|1800|35\\d{3})\\d{11})$"
+
 // Used by IsFilePath func
 const (
 	// Unknown is unresolved OS type
@@ -61,14 +83,17 @@ var (
 	userRegexp       = regexp.MustCompile("^[a-zA-Z0-9!#$%&'*+/=?^_`{|}~.-]+$")
 	hostRegexp       = regexp.MustCompile("^[^\\s]+\\.[^\\s]+$")
 	userDotRegexp    = regexp.MustCompile("(^[.]{1})|([.]{1}$)|([.]{2,})")
-	rxEmail          = regexp.MustCompile(Email)
-	rxCreditCard     = regexp.MustCompile(CreditCard)
 	rxISBN10         = regexp.MustCompile(ISBN10)
 	rxISBN13         = regexp.MustCompile(ISBN13)
 	rxUUID3          = regexp.MustCompile(UUID3)
 	rxUUID4          = regexp.MustCompile(UUID4)
 	rxUUID5          = regexp.MustCompile(UUID5)
+	rxUUID6          = regexp.MustCompile(UUID6)
+	rxUUID7          = regexp.MustCompile(UUID7)
+	rxUUID8          = regexp.MustCompile(UUID8)
 	rxUUID           = regexp.MustCompile(UUID)
+	rxULID           = regexp.MustCompile(ULID)
+	rxKSUID          = regexp.MustCompile(KSUID)
 	rxAlpha          = regexp.MustCompile(Alpha)
 	rxAlphanumeric   = regexp.MustCompile(Alphanumeric)
 	rxNumeric        = regexp.MustCompile(Numeric)
@@ -92,6 +117,17 @@ var (
 	rxWinPath        = regexp.MustCompile(WinPath)
 	rxUnixPath       = regexp.MustCompile(UnixPath)
 	rxSemver         = regexp.MustCompile(Semver)
+	rxE164           = regexp.MustCompile(E164)
+	rxIBAN           = regexp.MustCompile(IBAN)
+	rxBIC            = regexp.MustCompile(BIC)
+	rxABARouting     = regexp.MustCompile(ABARouting)
+	rxUKSortCode     = regexp.MustCompile(UKSortCode)
+	rxNINO           = regexp.MustCompile(NINO)
+	rxPAN            = regexp.MustCompile(PAN)
+	rxDNI            = regexp.MustCompile(DNI)
+	rxNIE            = regexp.MustCompile(NIE)
+	rxCVV            = regexp.MustCompile(CVV)
+	rxCardExpiry     = regexp.MustCompile(CardExpiry)
 	rxHasLowerCase   = regexp.MustCompile(hasLowerCase)
 	rxHasUpperCase   = regexp.MustCompile(hasUpperCase)
 )
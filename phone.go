@@ -0,0 +1,205 @@
+package govalidator
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// PhoneMetadata is the numbering plan of a region used to validate and normalize its phone numbers.
+// CountryCode is the calling code e.g. "44", NationalPrefix is the trunk prefix dialled
+// before national numbers e.g. "0" and NationalNumber matches the national significant
+// number (the number without the calling code or trunk prefix).
+type PhoneMetadata struct {
+	CountryCode    string
+	NationalPrefix string
+	NationalNumber *regexp.Regexp
+}
+
+// NewPhoneMetadata returns the metadata of a numbering plan, pattern is anchored for you.
+func NewPhoneMetadata(countryCode, nationalPrefix, pattern string) PhoneMetadata {
+	return PhoneMetadata{countryCode, nationalPrefix, regexp.MustCompile(`^(?:` + pattern + `)$`)}
+}
+
+// nanp returns the metadata of a North American Numbering Plan region with the given area codes.
+func nanp(areaCodes string) PhoneMetadata {
+	return NewPhoneMetadata("1", "1", `(?:`+areaCodes+`)[2-9]\d{6}`)
+}
+
+// PhoneMetadataMap maps ISO3166 alpha-2 codes (see ISO3166List) to their numbering plan.
+// The patterns check the number's length and leading digits, not whether it's assigned.
+// The bundled regions are the NANP countries (US, CA, AG, AI, AS, BB, BM, BS, DM, DO, GD, GU, JM, KN, KY, LC,
+// MP, MS, PR, SX, TC, TT, VC, VG, VI), MX, most of Europe (AT, BE, BG, BY, CH, CY, CZ, DE, DK, EE, ES, FI, FR,
+// GB, GR, HR, HU, IE, IS, IT, LT, LU, LV, MT, NL, NO, PL, PT, RO, RS, RU, SE, SI, SK, TR, UA), AE, AU, BD, CN,
+// HK, ID, IL, IN, JP, KR, KZ, MY, NZ, PH, PK, SA, SG, TH, TW, VN, EG, KE, MA, NG, ZA, AR, BR, CL, CO, PE and VE.
+// Numbers of other regions are invalid (NormalizePhone returns an unsupported region error) until they're added e.g.
+//
+//	govalidator.PhoneMetadataMap["XK"] = govalidator.NewPhoneMetadata("383", "0", `[2-9]\d{7}`)
+var PhoneMetadataMap = map[string]PhoneMetadata{
+	// North America
+	"US": nanp(`[2-9]\d{2}`),
+	"CA": nanp(`[2-9]\d{2}`),
+	"AG": nanp(`268`),
+	"AI": nanp(`264`),
+	"AS": nanp(`684`),
+	"BB": nanp(`246`),
+	"BM": nanp(`441`),
+	"BS": nanp(`242`),
+	"DM": nanp(`767`),
+	"DO": nanp(`809|829|849`),
+	"GD": nanp(`473`),
+	"GU": nanp(`671`),
+	"JM": nanp(`876|658`),
+	"KN": nanp(`869`),
+	"KY": nanp(`345`),
+	"LC": nanp(`758`),
+	"MP": nanp(`670`),
+	"MS": nanp(`664`),
+	"PR": nanp(`787|939`),
+	"SX": nanp(`721`),
+	"TC": nanp(`649`),
+	"TT": nanp(`868`),
+	"VC": nanp(`784`),
+	"VG": nanp(`284`),
+	"VI": nanp(`340`),
+	"MX": NewPhoneMetadata("52", "", `[1-9]\d{9}`),
+
+	// Europe
+	"AT": NewPhoneMetadata("43", "0", `[1-9]\d{3,12}`),
+	"BE": NewPhoneMetadata("32", "0", `4\d{8}|[1-9]\d{7}`),
+	"BG": NewPhoneMetadata("359", "0", `[2-9]\d{6,8}`),
+	"BY": NewPhoneMetadata("375", "80", `[1-4]\d{8}`),
+	"CH": NewPhoneMetadata("41", "0", `[1-9]\d{8}`),
+	"CY": NewPhoneMetadata("357", "", `[2-9]\d{7}`),
+	"CZ": NewPhoneMetadata("420", "", `[2-9]\d{8}`),
+	"DE": NewPhoneMetadata("49", "0", `1[5-7]\d{8,9}|[2-9]\d{5,11}|1[0-4]\d{4,9}`),
+	"DK": NewPhoneMetadata("45", "", `[2-9]\d{7}`),
+	"EE": NewPhoneMetadata("372", "", `[3-9]\d{6,7}`),
+	"ES": NewPhoneMetadata("34", "", `[5-9]\d{8}`),
+	"FI": NewPhoneMetadata("358", "0", `[1-9]\d{4,11}`),
+	"FR": NewPhoneMetadata("33", "0", `[1-9]\d{8}`),
+	"GB": NewPhoneMetadata("44", "0", `7\d{9}|[1-35689]\d{8,9}`),
+	"GR": NewPhoneMetadata("30", "", `[2-9]\d{9}`),
+	"HR": NewPhoneMetadata("385", "0", `[1-9]\d{6,8}`),
+	"HU": NewPhoneMetadata("36", "06", `[1-9]\d{7,8}`),
+	"IE": NewPhoneMetadata("353", "0", `[1-9]\d{6,9}`),
+	"IS": NewPhoneMetadata("354", "", `[4-9]\d{6}`),
+	"IT": NewPhoneMetadata("39", "", `0\d{5,10}|3\d{8,9}|[89]\d{5,9}`),
+	"LT": NewPhoneMetadata("370", "8", `[3-9]\d{7}`),
+	"LU": NewPhoneMetadata("352", "", `[2-9]\d{4,10}`),
+	"LV": NewPhoneMetadata("371", "", `[2-9]\d{7}`),
+	"MT": NewPhoneMetadata("356", "", `[2-9]\d{7}`),
+	"NL": NewPhoneMetadata("31", "0", `[1-9]\d{8}`),
+	"NO": NewPhoneMetadata("47", "", `[2-9]\d{7}`),
+	"PL": NewPhoneMetadata("48", "", `[1-9]\d{8}`),
+	"PT": NewPhoneMetadata("351", "", `[2-9]\d{8}`),
+	"RO": NewPhoneMetadata("40", "0", `[2-9]\d{8}`),
+	"RS": NewPhoneMetadata("381", "0", `[1-9]\d{6,9}`),
+	"RU": NewPhoneMetadata("7", "8", `[3489]\d{9}`),
+	"SE": NewPhoneMetadata("46", "0", `[1-9]\d{6,9}`),
+	"SI": NewPhoneMetadata("386", "0", `[1-9]\d{7}`),
+	"SK": NewPhoneMetadata("421", "0", `[2-9]\d{6,8}`),
+	"TR": NewPhoneMetadata("90", "0", `[2-58]\d{9}`),
+	"UA": NewPhoneMetadata("380", "0", `[3-9]\d{8}`),
+
+	// Asia and Oceania
+	"AE": NewPhoneMetadata("971", "0", `[2-9]\d{7,8}`),
+	"AU": NewPhoneMetadata("61", "0", `[2-478]\d{8}`),
+	"BD": NewPhoneMetadata("880", "0", `[1-9]\d{7,9}`),
+	"CN": NewPhoneMetadata("86", "0", `1[3-9]\d{9}|[2-9]\d{8,10}`),
+	"HK": NewPhoneMetadata("852", "", `[2-9]\d{7}`),
+	"ID": NewPhoneMetadata("62", "0", `[2-9]\d{7,11}`),
+	"IL": NewPhoneMetadata("972", "0", `[2-9]\d{7,8}`),
+	"IN": NewPhoneMetadata("91", "0", `[1-9]\d{9}`),
+	"JP": NewPhoneMetadata("81", "0", `[1-9]\d{8,9}`),
+	"KR": NewPhoneMetadata("82", "0", `[1-9]\d{7,9}`),
+	"KZ": NewPhoneMetadata("7", "8", `[67]\d{9}`),
+	"MY": NewPhoneMetadata("60", "0", `[1-9]\d{7,9}`),
+	"NZ": NewPhoneMetadata("64", "0", `[2-9]\d{7,9}`),
+	"PH": NewPhoneMetadata("63", "0", `[2-9]\d{7,9}`),
+	"PK": NewPhoneMetadata("92", "0", `[1-9]\d{8,9}`),
+	"SA": NewPhoneMetadata("966", "0", `[1-9]\d{7,8}`),
+	"SG": NewPhoneMetadata("65", "", `[3689]\d{7}`),
+	"TH": NewPhoneMetadata("66", "0", `[2-9]\d{7,8}`),
+	"TW": NewPhoneMetadata("886", "0", `[2-9]\d{7,8}`),
+	"VN": NewPhoneMetadata("84", "0", `[1-9]\d{8,9}`),
+
+	// Africa
+	"EG": NewPhoneMetadata("20", "0", `[1-9]\d{7,9}`),
+	"KE": NewPhoneMetadata("254", "0", `[1-9]\d{8}`),
+	"MA": NewPhoneMetadata("212", "0", `[5-8]\d{8}`),
+	"NG": NewPhoneMetadata("234", "0", `[1-9]\d{7,9}`),
+	"ZA": NewPhoneMetadata("27", "0", `[1-9]\d{8}`),
+
+	// South America
+	"AR": NewPhoneMetadata("54", "0", `[1-9]\d{9,10}`),
+	"BR": NewPhoneMetadata("55", "0", `[1-9]{2}\d{8,9}`),
+	"CL": NewPhoneMetadata("56", "", `[2-9]\d{8}`),
+	"CO": NewPhoneMetadata("57", "", `[1-8]\d{7,9}`),
+	"PE": NewPhoneMetadata("51", "0", `[1-9]\d{7,8}`),
+	"VE": NewPhoneMetadata("58", "0", `[2-9]\d{9}`),
+}
+
+// IsE164 checks if the string is a phone number in E.164 format e.g. +442071838750.
+func IsE164(str string) bool {
+	return rxE164.MatchString(str)
+}
+
+// IsPhone checks if the string is a phone number of the region (an ISO3166 alpha-2 code in PhoneMetadataMap),
+// either in its national format e.g. (020) 7183 8750 or international format e.g. +44 20 7183 8750.
+// It's false for regions which aren't in PhoneMetadataMap.
+func IsPhone(str, region string) bool {
+	_, err := NormalizePhone(str, region)
+	return err == nil
+}
+
+// NormalizePhone returns the phone number of the region in E.164 format e.g. 020 7183 8750 in GB becomes +442071838750.
+// Spaces, dots, hyphens and parentheses are removed, an error is returned if the number isn't valid for the region
+// or the region isn't in PhoneMetadataMap. International numbers can start with + or 00, and have the trunk prefix
+// in parentheses e.g. +44 (0)20 7183 8750.
+func NormalizePhone(str, region string) (string, error) {
+	metadata, ok := PhoneMetadataMap[strings.ToUpper(region)]
+	if !ok {
+		return "", fmt.Errorf("unsupported phone region %s, see PhoneMetadataMap", region)
+	}
+
+	number := strings.TrimSpace(str)
+	international := strings.HasPrefix(number, "+") || strings.HasPrefix(number, "00")
+	if international {
+		number = strings.Replace(number, "(0)", "", 1)
+	}
+	number = phoneSeparators.Replace(number)
+	if strings.HasPrefix(number, "+") {
+		number = number[1:]
+	} else if international {
+		number = number[2:]
+	}
+	if number == "" || !IsNumeric(number) {
+		return "", fmt.Errorf("%s is not a phone number", str)
+	}
+
+	if international {
+		if !strings.HasPrefix(number, metadata.CountryCode) {
+			return "", fmt.Errorf("%s is not a phone number of %s", str, region)
+		}
+		number = number[len(metadata.CountryCode):]
+	} else if prefix := metadata.NationalPrefix; prefix != "" && strings.HasPrefix(number, prefix) &&
+		metadata.NationalNumber.MatchString(number[len(prefix):]) {
+		number = number[len(prefix):]
+	}
+
+	e164 := "+" + metadata.CountryCode + number
+	if !metadata.NationalNumber.MatchString(number) || !IsE164(e164) {
+		return "", fmt.Errorf("%s is not a phone number of %s", str, region)
+	}
+	return e164, nil
+}
+
+var phoneSeparators = strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "")
+
+func isPhoneRaw(str string, params ...string) bool {
+	if len(params) == 1 {
+		return IsPhone(str, params[0])
+	}
+	return false
+}
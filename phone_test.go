@@ -0,0 +1,122 @@
+package govalidator
+
+import "testing"
+
+func TestIsE164(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"+442071838750", true},
+		{"+12125551234", true},
+		{"+123456789012345", true},
+		{"+1234567890123456", false},
+		{"442071838750", false},
+		{"+0442071838750", false},
+		{"+44 20 7183 8750", false},
+	}
+	for _, test := range tests {
+		actual := IsE164(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsE164(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestNormalizePhone(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		region   string
+		expected string
+	}{
+		{"020 7183 8750", "GB", "+442071838750"},
+		{"(020) 7183-8750", "gb", "+442071838750"},
+		{"+44 20 7183 8750", "GB", "+442071838750"},
+		{"07911 123456", "GB", "+447911123456"},
+		{"(212) 555-1234", "US", "+12125551234"},
+		{"1-212-555-1234", "US", "+12125551234"},
+		{"212.555.1234", "CA", "+12125551234"},
+		{"876 555 1234", "JM", "+18765551234"},
+		{"212 555 1234", "JM", ""},
+		{"030 1234567", "DE", "+49301234567"},
+		{"0151 23456789", "DE", "+4915123456789"},
+		{"01 23 45 67 89", "FR", "+33123456789"},
+		{"06 12 34 56 78", "FR", "+33612345678"},
+		{"06 12 34 56 7", "FR", ""},
+		{"06 1234 5678", "IT", "+390612345678"},
+		{"333 123 4567", "IT", "+393331234567"},
+		{"0412 345 678", "AU", "+61412345678"},
+		{"8 (912) 345-67-89", "RU", "+79123456789"},
+		{"+7 912 345 67 89", "RU", "+79123456789"},
+		{"+7 912 345 67 89", "KZ", ""},
+		{"090-1234-5678", "JP", "+819012345678"},
+		{"0044 20 7183 8750", "GB", "+442071838750"},
+		{"+44 (0)20 7183 8750", "GB", "+442071838750"},
+		{"0044 (0)7911 123456", "GB", "+447911123456"},
+		{"+49 (0)30 1234567", "DE", "+49301234567"},
+		{"0033 6 12 34 56 78", "FR", "+33612345678"},
+		{"+44 (0)(0)20 7183 8750", "GB", ""},
+		{"00 7911 123456", "GB", ""},
+		{"+44 20 7183 8750", "FR", ""},
+		{"020 7183 8750", "XX", ""},
+		{"679 123 4567", "FJ", ""},
+		{"020 7183 875a", "GB", ""},
+		{"++44 20 7183 8750", "GB", ""},
+		{"", "GB", ""},
+	}
+	for _, test := range tests {
+		actual, err := NormalizePhone(test.param, test.region)
+		if actual != test.expected {
+			t.Errorf("Expected NormalizePhone(%q, %q) to be %v, got %v, err %v", test.param, test.region, test.expected, actual, err)
+		}
+		if valid := IsPhone(test.param, test.region); valid != (test.expected != "") {
+			t.Errorf("Expected IsPhone(%q, %q) to be %v, got %v", test.param, test.region, test.expected != "", valid)
+		}
+	}
+}
+
+func TestNormalizePhoneUnsupportedRegion(t *testing.T) {
+	t.Parallel()
+
+	expected := "unsupported phone region FJ, see PhoneMetadataMap"
+	if _, err := NormalizePhone("679 123 4567", "FJ"); err == nil || err.Error() != expected {
+		t.Errorf("Expected NormalizePhone to fail with %q, got %v", expected, err)
+	}
+	if ok, _ := Validate(struct {
+		Mobile string `valid:"phone(FJ)"`
+	}{"679 123 4567"}); ok {
+		t.Errorf("Expected phone(FJ) to fail for a region which isn't in PhoneMetadataMap")
+	}
+}
+
+func TestPhoneMetadataMapRegions(t *testing.T) {
+	t.Parallel()
+
+	for region, metadata := range PhoneMetadataMap {
+		if !IsISO3166Alpha2(region) {
+			t.Errorf("Expected PhoneMetadataMap region %s to be an ISO3166 alpha-2 code", region)
+		}
+		if !IsNumeric(metadata.CountryCode) || !IsNumeric(metadata.NationalPrefix) {
+			t.Errorf("Expected PhoneMetadataMap[%s] codes to be numeric, got %q and %q", region, metadata.CountryCode, metadata.NationalPrefix)
+		}
+	}
+}
+
+func TestValidatePhone(t *testing.T) {
+	type Contact struct {
+		Mobile string `valid:"phone(GB)"`
+		Fax    string `valid:"e164,optional"`
+	}
+
+	if ok, err := Validate(Contact{"07911 123456", "+442071838750"}); !ok {
+		t.Errorf("Expected phone(GB) and e164 to pass, got %v", err)
+	}
+	if ok, err := Validate(Contact{"(212) 555-1234", "020 7183 8750"}); ok {
+		t.Errorf("Expected phone(GB) and e164 to fail, got %v", err)
+	}
+}
@@ -0,0 +1,221 @@
+package govalidator
+
+import (
+	"regexp"
+	"strings"
+)
+
+// postalCode compiles an (anchored, case insensitive) postal code pattern.
+func postalCode(pattern string) *regexp.Regexp {
+	return regexp.MustCompile(`(?i)^(?:` + pattern + `)$`)
+}
+
+var (
+	rxPostalCode3 = postalCode(`\d{3}`)
+	rxPostalCode4 = postalCode(`\d{4}`)
+	rxPostalCode5 = postalCode(`\d{5}`)
+	rxPostalCode6 = postalCode(`\d{6}`)
+)
+
+// PostalCodeMap maps ISO3166 alpha-2 codes (see ISO3166List) of the countries that use postal codes to their pattern,
+// based on https://chromium-i18n.appspot.com/ssl-address and the Universal Postal Union.
+// Countries can be added or changed e.g.
+//
+//	govalidator.PostalCodeMap["XK"] = regexp.MustCompile(`^\d{5}$`)
+var PostalCodeMap = map[string]*regexp.Regexp{
+	"AD": postalCode(`AD[1-7]0\d`),
+	"AF": rxPostalCode4,
+	"AI": postalCode(`(?:AI-)?2640`),
+	"AL": rxPostalCode4,
+	"AM": rxPostalCode4,
+	"AR": postalCode(`(?:[A-HJ-NP-Z])?\d{4}(?:[A-Z]{3})?`),
+	"AS": postalCode(`96799(?:[ -]\d{4})?`),
+	"AT": rxPostalCode4,
+	"AU": rxPostalCode4,
+	"AX": postalCode(`22\d{3}`),
+	"AZ": postalCode(`(?:AZ ?)?\d{4}`),
+	"BA": rxPostalCode5,
+	"BB": postalCode(`BB\d{5}`),
+	"BD": rxPostalCode4,
+	"BE": rxPostalCode4,
+	"BG": rxPostalCode4,
+	"BH": postalCode(`(?:\d|1[0-2])\d{2}`),
+	"BL": postalCode(`9[78]97\d`),
+	"BM": postalCode(`[A-Z]{2} ?[A-Z0-9]{2}`),
+	"BN": postalCode(`[A-Z]{2} ?\d{4}`),
+	"BR": postalCode(`\d{5}-?\d{3}`),
+	"BT": rxPostalCode5,
+	"BY": rxPostalCode6,
+	"CA": postalCode(`[ABCEGHJKLMNPRSTVXY]\d[ABCEGHJ-NPRSTV-Z] ?\d[ABCEGHJ-NPRSTV-Z]\d`),
+	"CC": postalCode(`6799`),
+	"CH": rxPostalCode4,
+	"CL": postalCode(`\d{7}`),
+	"CN": rxPostalCode6,
+	"CO": rxPostalCode6,
+	"CR": postalCode(`\d{4,5}|\d{3}-\d{4}`),
+	"CU": rxPostalCode5,
+	"CV": rxPostalCode4,
+	"CX": postalCode(`6798`),
+	"CY": rxPostalCode4,
+	"CZ": postalCode(`\d{3} ?\d{2}`),
+	"DE": rxPostalCode5,
+	"DK": rxPostalCode4,
+	"DO": rxPostalCode5,
+	"DZ": rxPostalCode5,
+	"EC": rxPostalCode6,
+	"EE": rxPostalCode5,
+	"EG": rxPostalCode5,
+	"ES": postalCode(`(?:0[1-9]|[1-4]\d|5[0-2])\d{3}`),
+	"ET": rxPostalCode4,
+	"FI": rxPostalCode5,
+	"FK": postalCode(`FIQQ 1ZZ`),
+	"FM": postalCode(`9694[1-4](?:[ -]\d{4})?`),
+	"FO": rxPostalCode3,
+	"FR": postalCode(`\d{2} ?\d{3}`),
+	"GB": postalCode(`GIR ?0AA|(?:[A-PR-UWYZ](?:\d{1,2}|[A-HK-Y]\d{1,2}|\d[A-HJKSTUW]|[A-HK-Y]\d[ABEHMNPRV-Y]) ?\d[ABD-HJLNP-UW-Z]{2})`),
+	"GE": rxPostalCode4,
+	"GF": postalCode(`9[78]3\d{2}`),
+	"GG": postalCode(`GY\d[\dA-Z]? ?\d[ABD-HJLN-UW-Z]{2}`),
+	"GI": postalCode(`GX11 ?1AA`),
+	"GL": postalCode(`39\d{2}`),
+	"GN": rxPostalCode3,
+	"GP": postalCode(`9[78][01]\d{2}`),
+	"GR": postalCode(`\d{3} ?\d{2}`),
+	"GS": postalCode(`SIQQ 1ZZ`),
+	"GT": rxPostalCode5,
+	"GU": postalCode(`969(?:[12]\d|3[12])(?:[ -]\d{4})?`),
+	"GW": rxPostalCode4,
+	"HM": rxPostalCode4,
+	"HN": rxPostalCode5,
+	"HR": rxPostalCode5,
+	"HT": rxPostalCode4,
+	"HU": rxPostalCode4,
+	"ID": rxPostalCode5,
+	"IE": postalCode(`(?:[AC-FHKNPRTV-Y]\d{2}|D6W) ?[0-9AC-FHKNPRTV-Y]{4}`),
+	"IL": postalCode(`\d{5}(?:\d{2})?`),
+	"IM": postalCode(`IM\d[\dA-Z]? ?\d[ABD-HJLN-UW-Z]{2}`),
+	"IN": postalCode(`[1-9]\d{5}`),
+	"IO": postalCode(`BBND 1ZZ`),
+	"IQ": rxPostalCode5,
+	"IR": postalCode(`\d{5}-?\d{5}`),
+	"IS": rxPostalCode3,
+	"IT": rxPostalCode5,
+	"JE": postalCode(`JE\d[\dA-Z]? ?\d[ABD-HJLN-UW-Z]{2}`),
+	"JO": rxPostalCode5,
+	"JP": postalCode(`\d{3}-?\d{4}`),
+	"KE": rxPostalCode5,
+	"KG": rxPostalCode6,
+	"KH": rxPostalCode5,
+	"KR": rxPostalCode5,
+	"KW": rxPostalCode5,
+	"KY": postalCode(`KY\d-\d{4}`),
+	"KZ": rxPostalCode6,
+	"LA": rxPostalCode5,
+	"LB": postalCode(`\d{4}(?: ?\d{4})?`),
+	"LC": postalCode(`LC\d{2} \d{3}`),
+	"LI": postalCode(`948[5-9]|949[0-8]`),
+	"LK": rxPostalCode5,
+	"LR": rxPostalCode4,
+	"LS": rxPostalCode3,
+	"LT": postalCode(`(?:LT-)?\d{5}`),
+	"LU": postalCode(`(?:L-)?\d{4}`),
+	"LV": postalCode(`LV-\d{4}`),
+	"MA": rxPostalCode5,
+	"MC": postalCode(`980\d{2}`),
+	"MD": postalCode(`(?:MD-?)?\d{4}`),
+	"ME": postalCode(`8\d{4}`),
+	"MF": postalCode(`9[78]97\d`),
+	"MG": rxPostalCode3,
+	"MH": postalCode(`969[67]\d(?:[ -]\d{4})?`),
+	"MK": rxPostalCode4,
+	"MM": rxPostalCode5,
+	"MN": rxPostalCode5,
+	"MP": postalCode(`9695[012](?:[ -]\d{4})?`),
+	"MQ": postalCode(`9[78]2\d{2}`),
+	"MT": postalCode(`[A-Z]{3} ?\d{2,4}`),
+	"MU": postalCode(`\d{3}(?:\d{2}|[A-Z]{2}\d{3})`),
+	"MV": rxPostalCode5,
+	"MX": rxPostalCode5,
+	"MY": rxPostalCode5,
+	"MZ": rxPostalCode4,
+	"NC": postalCode(`988\d{2}`),
+	"NE": rxPostalCode4,
+	"NF": postalCode(`2899`),
+	"NG": rxPostalCode6,
+	"NI": rxPostalCode5,
+	"NL": postalCode(`\d{4} ?(?:[A-RT-Z][A-Z]|S[BCE-RT-Z])`),
+	"NO": rxPostalCode4,
+	"NP": rxPostalCode5,
+	"NZ": rxPostalCode4,
+	"OM": rxPostalCode3,
+	"PE": postalCode(`(?:LIMA \d{1,2}|CALLAO(?: \d{1,2})?|\d{5})`),
+	"PF": postalCode(`987\d{2}`),
+	"PG": rxPostalCode3,
+	"PH": rxPostalCode4,
+	"PK": rxPostalCode5,
+	"PL": postalCode(`\d{2}-\d{3}`),
+	"PM": postalCode(`9[78]5\d{2}`),
+	"PN": postalCode(`PCRN 1ZZ`),
+	"PR": postalCode(`00[679]\d{2}(?:[ -]\d{4})?`),
+	"PS": rxPostalCode3,
+	"PT": postalCode(`\d{4}-\d{3}`),
+	"PW": postalCode(`96940`),
+	"PY": rxPostalCode4,
+	"RE": postalCode(`9[78]4\d{2}`),
+	"RO": rxPostalCode6,
+	"RS": postalCode(`\d{5,6}`),
+	"RU": rxPostalCode6,
+	"SA": postalCode(`\d{5}(?:-\d{4})?`),
+	"SD": rxPostalCode5,
+	"SE": postalCode(`\d{3} ?\d{2}`),
+	"SG": rxPostalCode6,
+	"SH": postalCode(`(?:ASCN|STHL) 1ZZ`),
+	"SI": postalCode(`(?:SI-)?\d{4}`),
+	"SJ": rxPostalCode4,
+	"SK": postalCode(`\d{3} ?\d{2}`),
+	"SM": postalCode(`4789\d`),
+	"SN": rxPostalCode5,
+	"SO": postalCode(`[A-Z]{2} ?\d{5}`),
+	"SV": postalCode(`CP [1-3]\d{3}`),
+	"SZ": postalCode(`[HLMS]\d{3}`),
+	"TC": postalCode(`TKCA 1ZZ`),
+	"TH": rxPostalCode5,
+	"TJ": rxPostalCode6,
+	"TM": rxPostalCode6,
+	"TN": rxPostalCode4,
+	"TR": rxPostalCode5,
+	"TT": rxPostalCode6,
+	"TW": postalCode(`\d{3}(?:\d{2,3})?`),
+	"TZ": postalCode(`\d{4,5}`),
+	"UA": rxPostalCode5,
+	"UM": postalCode(`96898`),
+	"US": postalCode(`\d{5}(?:[ -]\d{4})?`),
+	"UY": rxPostalCode5,
+	"UZ": rxPostalCode6,
+	"VA": postalCode(`00120`),
+	"VC": postalCode(`VC\d{4}`),
+	"VE": postalCode(`\d{4}(?:-[A-Z])?`),
+	"VG": postalCode(`VG\d{4}`),
+	"VI": postalCode(`008(?:[0-4]\d|5[01])(?:[ -]\d{4})?`),
+	"VN": rxPostalCode6,
+	"WF": postalCode(`986\d{2}`),
+	"YT": postalCode(`976\d{2}`),
+	"ZA": rxPostalCode4,
+	"ZM": rxPostalCode5,
+}
+
+// IsPostalCode checks if the string is a postal code of the country (an ISO3166 alpha-2 code in PostalCodeMap).
+// It returns false for countries without postal codes e.g. HK.
+func IsPostalCode(str, countryCode string) bool {
+	if rx, ok := PostalCodeMap[strings.ToUpper(countryCode)]; ok {
+		return rx.MatchString(str)
+	}
+	return false
+}
+
+func isPostalCodeRaw(str string, params ...string) bool {
+	if len(params) == 1 {
+		return IsPostalCode(str, params[0])
+	}
+	return false
+}
@@ -0,0 +1,101 @@
+package govalidator
+
+import "testing"
+
+func TestIsPostalCode(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		country  string
+		expected bool
+	}{
+		{"", "US", false},
+		{"90210", "US", true},
+		{"90210-1234", "US", true},
+		{"9021", "US", false},
+		{"SW1A 1AA", "GB", true},
+		{"sw1a1aa", "gb", true},
+		{"EC1A 1BB", "GB", true},
+		{"GIR 0AA", "GB", true},
+		{"QW1A 1AA", "GB", false},
+		{"K1A 0B1", "CA", true},
+		{"K1A0B1", "CA", true},
+		{"D1A 0B1", "CA", false},
+		{"10115", "DE", true},
+		{"1011", "DE", false},
+		{"75008", "FR", true},
+		{"75 008", "FR", true},
+		{"1012 AB", "NL", true},
+		{"1012 SA", "NL", false},
+		{"1234-567", "PT", true},
+		{"1234567", "PT", false},
+		{"00-950", "PL", true},
+		{"100-0001", "JP", true},
+		{"1000001", "JP", true},
+		{"110001", "IN", true},
+		{"010001", "IN", false},
+		{"01310-100", "BR", true},
+		{"D02 X285", "IE", true},
+		{"LV-1050", "LV", true},
+		{"1050", "LV", false},
+		{"2000", "AU", true},
+		{"28013", "ES", true},
+		{"99999", "ES", false},
+		{"12345", "HK", false},
+		{"12345", "XX", false},
+	}
+	for _, test := range tests {
+		actual := IsPostalCode(test.param, test.country)
+		if actual != test.expected {
+			t.Errorf("Expected IsPostalCode(%q, %q) to be %v, got %v", test.param, test.country, test.expected, actual)
+		}
+	}
+}
+
+func TestPostalCodeMapCountries(t *testing.T) {
+	t.Parallel()
+
+	for country := range PostalCodeMap {
+		if !IsISO3166Alpha2(country) {
+			t.Errorf("Expected PostalCodeMap country %s to be an ISO3166 alpha-2 code", country)
+		}
+	}
+}
+
+func TestValidatePostalCode(t *testing.T) {
+	type Address struct {
+		Country  string
+		Postcode string `valid:"postcode_iso3166_field(Country)"`
+		Zip      string `valid:"postcode(US),optional"`
+	}
+
+	var tests = []struct {
+		param    Address
+		expected bool
+	}{
+		{Address{Country: "GB", Postcode: "SW1A 1AA"}, true},
+		{Address{Country: "US", Postcode: "SW1A 1AA"}, false},
+		{Address{Country: "US", Postcode: "90210", Zip: "90210"}, true},
+		{Address{Country: "US", Postcode: "90210", Zip: "9021"}, false},
+		{Address{Postcode: "90210"}, false},
+	}
+	for _, test := range tests {
+		actual, err := Validate(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected Validate(%v) to be %v, got %v", test.param, test.expected, actual)
+			if err != nil {
+				t.Errorf("Got Error on Validate(%v): %v", test.param, err)
+			}
+		}
+	}
+
+	type PointerAddress struct {
+		Country  *string
+		Postcode string `valid:"postcode_iso3166_field(Country)"`
+	}
+	country := "DE"
+	if ok, err := Validate(&PointerAddress{&country, "10115"}); !ok {
+		t.Errorf("Expected postcode_iso3166_field to use the pointer field, got %v", err)
+	}
+}
@@ -0,0 +1,73 @@
+package govalidator
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// ProblemContentType is the media type of an RFC 7807 problem details document.
+const ProblemContentType = "application/problem+json"
+
+// Problem is an RFC 7807 problem details document listing the validation errors
+// of a struct. It complements the `{"errors":{...}}` map returned by Validate.
+type Problem struct {
+	Type          string         `json:"type"`
+	Title         string         `json:"title"`
+	Status        int            `json:"status"`
+	Detail        string         `json:"detail,omitempty"`
+	InvalidParams []InvalidParam `json:"invalid_params"`
+}
+
+// InvalidParam is a single validation error of a Problem.
+// Name is the JSON path of the field e.g. `work[0].zip` and Code is the
+// validator that failed e.g. `required` or `email`.
+type InvalidParam struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+	Code   string `json:"code"`
+}
+
+// NewProblem returns a 400 Bad Request problem for the given invalid params.
+// The returned fields can be altered (e.g. Type set to your own URI) before writing it.
+func NewProblem(params []InvalidParam) *Problem {
+	if params == nil {
+		params = []InvalidParam{}
+	}
+	return &Problem{
+		Type:          "about:blank",
+		Title:         http.StatusText(http.StatusBadRequest),
+		Status:        http.StatusBadRequest,
+		Detail:        fmt.Sprintf("%d validation error(s) found", len(params)),
+		InvalidParams: params,
+	}
+}
+
+// ValidateProblem validates a struct using its `valid` field tags, just like
+// Validate, but returns the errors as an RFC 7807 problem (nil when valid).
+func ValidateProblem(i interface{}) (bool, *Problem) {
+	if valid, _ := Validate(i); valid {
+		return true, nil
+	}
+	return false, NewProblem(invalidParams)
+}
+
+// WriteProblem writes the problem to w as `application/problem+json` using
+// the problem's status code.
+func WriteProblem(w http.ResponseWriter, p *Problem) error {
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(p.Status)
+	return json.NewEncoder(w).Encode(p)
+}
+
+func appendInvalidParam(param InvalidParam) {
+	if invalidParams == nil {
+		return
+	}
+	for _, p := range invalidParams {
+		if p == param {
+			return
+		}
+	}
+	invalidParams = append(invalidParams, param)
+}
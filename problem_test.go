@@ -0,0 +1,81 @@
+package govalidator
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type ProblemAddress struct {
+	Zip string `json:"zip" valid:"numeric,required"`
+}
+
+type ProblemUser struct {
+	Name  string           `json:"name" valid:"required"`
+	Email string           `json:"email" valid:"email~Not an email"`
+	Age   int              `valid:"range(18|99)"`
+	Home  ProblemAddress   `json:"home"`
+	Work  []ProblemAddress `json:"work" valid:"optional"`
+}
+
+func TestValidateProblemFails(t *testing.T) {
+	user := ProblemUser{
+		Email: `mick.com`,
+		Age:   12,
+		Home:  ProblemAddress{Zip: `abc`},
+		Work:  []ProblemAddress{{Zip: `123`}, {}},
+	}
+
+	valid, problem := ValidateProblem(user)
+
+	assert.False(t, valid)
+	assert.NotNil(t, problem)
+
+	jsonBytes, _ := json.Marshal(problem)
+	actualJSON := string(jsonBytes)
+	expectedJSON := `{
+		"type": "about:blank",
+		"title": "Bad Request",
+		"status": 400,
+		"detail": "5 validation error(s) found",
+		"invalid_params": [
+			{"name": "name", "reason": "non zero value required", "code": "required"},
+			{"name": "email", "reason": "Not an email", "code": "email"},
+			{"name": "Age", "reason": "12 does not validate as range(18|99)", "code": "range"},
+			{"name": "home.zip", "reason": "abc does not validate as numeric", "code": "numeric"},
+			{"name": "work[1].zip", "reason": "non zero value required", "code": "required"}
+		]
+	}`
+
+	assert.JSONEq(t, expectedJSON, actualJSON)
+}
+
+func TestValidateProblemPasses(t *testing.T) {
+	user := ProblemUser{
+		Name:  `Mick`,
+		Email: `mick@gmail.com`,
+		Age:   30,
+		Home:  ProblemAddress{Zip: `123`},
+	}
+
+	valid, problem := ValidateProblem(user)
+
+	assert.True(t, valid)
+	assert.Nil(t, problem)
+}
+
+func TestWriteProblem(t *testing.T) {
+	problem := NewProblem([]InvalidParam{{Name: "email", Reason: "Not an email", Code: "email"}})
+	recorder := httptest.NewRecorder()
+
+	err := WriteProblem(recorder, problem)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Equal(t, ProblemContentType, recorder.Header().Get("Content-Type"))
+	expectedJSON := `{"type":"about:blank","title":"Bad Request","status":400,"detail":"1 validation error(s) found","invalid_params":[{"name":"email","reason":"Not an email","code":"email"}]}`
+	assert.JSONEq(t, expectedJSON, recorder.Body.String())
+}
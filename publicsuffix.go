@@ -0,0 +1,130 @@
+package govalidator
+
+import (
+	_ "embed" // for the public suffix list
+	"errors"
+	"strings"
+	"sync"
+)
+
+// publicSuffixList is a copy of https://publicsuffix.org/list/public_suffix_list.dat (ICANN and private domains),
+// update it by replacing public_suffix_list.dat.
+//
+//go:embed public_suffix_list.dat
+var publicSuffixList string
+
+// publicSuffixRules are the rules of the public suffix list in ASCII, loaded on first use.
+var publicSuffixRules struct {
+	once  sync.Once
+	rules map[string]struct{}
+}
+
+func loadPublicSuffixRules() map[string]struct{} {
+	publicSuffixRules.once.Do(func() {
+		rules := make(map[string]struct{})
+		for _, line := range strings.Split(publicSuffixList, "\n") {
+			rule := strings.TrimSpace(line)
+			if rule == "" || strings.HasPrefix(rule, "//") {
+				continue
+			}
+			prefix := ""
+			switch {
+			case strings.HasPrefix(rule, "!"):
+				prefix, rule = "!", rule[1:]
+			case strings.HasPrefix(rule, "*."):
+				prefix, rule = "*.", rule[2:]
+			}
+			if ascii, err := ToASCIIDomain(rule); err == nil {
+				rules[prefix+ascii] = struct{}{}
+			}
+		}
+		publicSuffixRules.rules = rules
+	})
+	return publicSuffixRules.rules
+}
+
+// publicSuffixLabels returns the number of labels of the public suffix of an ASCII domain name,
+// falling back to the default * rule (the TLD) if no rule of the list matches.
+func publicSuffixLabels(labels []string) int {
+	rules := loadPublicSuffixRules()
+	for i := range labels {
+		name := strings.Join(labels[i:], ".")
+		if _, ok := rules["!"+name]; ok {
+			return len(labels) - i - 1
+		}
+		if _, ok := rules[name]; ok {
+			return len(labels) - i
+		}
+		if i+1 < len(labels) {
+			if _, ok := rules["*."+strings.Join(labels[i+1:], ".")]; ok {
+				return len(labels) - i
+			}
+		}
+	}
+	return 1
+}
+
+// splitDomain converts a domain name to ASCII (see ToASCIIDomain) and splits it into labels, ignoring a trailing dot.
+func splitDomain(domain string) ([]string, error) {
+	ascii, err := ToASCIIDomain(domain)
+	if err != nil {
+		return nil, err
+	}
+	return strings.Split(strings.TrimSuffix(ascii, "."), "."), nil
+}
+
+// PublicSuffix returns the public suffix of a domain name in ASCII e.g. co.uk for www.example.co.uk,
+// using the embedded public suffix list. Domains with an unlisted TLD have the TLD as their public suffix.
+func PublicSuffix(domain string) (string, error) {
+	labels, err := splitDomain(domain)
+	if err != nil {
+		return "", err
+	}
+	n := publicSuffixLabels(labels)
+	return strings.Join(labels[len(labels)-n:], "."), nil
+}
+
+// EffectiveTLDPlusOne returns the registrable domain (the public suffix and one more label) of a domain name
+// in ASCII e.g. example.co.uk for www.example.co.uk. It fails if the domain name is a public suffix.
+func EffectiveTLDPlusOne(domain string) (string, error) {
+	labels, err := splitDomain(domain)
+	if err != nil {
+		return "", err
+	}
+	n := publicSuffixLabels(labels)
+	if n >= len(labels) {
+		return "", errors.New("domain name " + domain + " is a public suffix")
+	}
+	return strings.Join(labels[len(labels)-n-1:], "."), nil
+}
+
+// IsFQDN checks if the string is a fully qualified domain name (see IsDomainName) with at least two labels
+// and a top level domain in the public suffix list e.g. www.example.com or bücher.example.de.
+func IsFQDN(str string) bool {
+	if !IsDomainName(str) {
+		return false
+	}
+	labels, _ := splitDomain(str)
+	if len(labels) < 2 {
+		return false
+	}
+	rules := loadPublicSuffixRules()
+	tld := labels[len(labels)-1]
+	_, listed := rules[tld]
+	_, wildcard := rules["*."+tld]
+	return listed || wildcard
+}
+
+// IsRegistrableDomain checks if the string is a registrable domain name i.e. its own eTLD+1
+// e.g. example.co.uk but not www.example.co.uk or co.uk (see EffectiveTLDPlusOne).
+func IsRegistrableDomain(str string) bool {
+	if !IsFQDN(str) {
+		return false
+	}
+	registrable, err := EffectiveTLDPlusOne(str)
+	if err != nil {
+		return false
+	}
+	labels, _ := splitDomain(str)
+	return registrable == strings.Join(labels, ".")
+}
@@ -0,0 +1,89 @@
+package govalidator
+
+import "testing"
+
+func TestPublicSuffix(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		suffix   string
+		etldPlus string
+	}{
+		{"www.example.com", "com", "example.com"},
+		{"www.example.co.uk", "co.uk", "example.co.uk"},
+		{"example.co.uk.", "co.uk", "example.co.uk"},
+		{"user.github.io", "github.io", "user.github.io"},
+		{"a.b.example.ck", "example.ck", "b.example.ck"},
+		{"www.ck", "ck", "www.ck"},
+		{"foo.city.kawasaki.jp", "kawasaki.jp", "city.kawasaki.jp"},
+		{"shop.example.公司.cn", "xn--55qx5d.cn", "example.xn--55qx5d.cn"},
+		{"www.bücher.de", "de", "xn--bcher-kva.de"},
+		{"host.example.unknowntld", "unknowntld", "example.unknowntld"},
+		{"co.uk", "co.uk", ""},
+		{"com", "com", ""},
+	}
+	for _, test := range tests {
+		suffix, err := PublicSuffix(test.param)
+		if suffix != test.suffix || err != nil {
+			t.Errorf("Expected PublicSuffix(%q) to be %q, got %q, %v", test.param, test.suffix, suffix, err)
+		}
+		etldPlus, err := EffectiveTLDPlusOne(test.param)
+		if etldPlus != test.etldPlus || (err == nil) != (test.etldPlus != "") {
+			t.Errorf("Expected EffectiveTLDPlusOne(%q) to be %q, got %q, %v", test.param, test.etldPlus, etldPlus, err)
+		}
+	}
+	if _, err := PublicSuffix("-invalid.com"); err == nil {
+		t.Error("Expected PublicSuffix(\"-invalid.com\") to fail")
+	}
+}
+
+func TestIsFQDN(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"example.com", true},
+		{"www.example.com.", true},
+		{"bücher.example.de", true},
+		{"example.ck", true},
+		{"localhost", false},
+		{"host.localdomain", false},
+		{"example.unknowntld", false},
+		{"127.0.0.1", false},
+		{"", false},
+	}
+	for _, test := range tests {
+		actual := IsFQDN(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsFQDN(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestIsRegistrableDomain(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"example.com", true},
+		{"Example.CO.UK", true},
+		{"bücher.de", true},
+		{"user.github.io", true},
+		{"www.example.com", false},
+		{"co.uk", false},
+		{"github.io", false},
+		{"com", false},
+		{"example.unknowntld", false},
+	}
+	for _, test := range tests {
+		actual := IsRegistrableDomain(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsRegistrableDomain(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package govalidator
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+// Punycode parameters, see https://tools.ietf.org/html/rfc3492#section-5
+const (
+	punycodeBase        int32 = 36
+	punycodeDamp        int32 = 700
+	punycodeInitialBias int32 = 72
+	punycodeInitialN    int32 = 128
+	punycodeSkew        int32 = 38
+	punycodeTMax        int32 = 26
+	punycodeTMin        int32 = 1
+	acePrefix                 = "xn--"
+)
+
+var errPunycode = errors.New("invalid punycode")
+
+// punycodeEncode encodes a Unicode string to punycode (without the xn-- prefix).
+func punycodeEncode(s string) (string, error) {
+	output := make([]byte, 0, 2*len(s))
+	delta, n, bias := int32(0), punycodeInitialN, punycodeInitialBias
+	b, remaining := int32(0), int32(0)
+	for _, r := range s {
+		if r < 0x80 {
+			b++
+			output = append(output, byte(r))
+		} else {
+			remaining++
+		}
+	}
+	h := b
+	if b > 0 {
+		output = append(output, '-')
+	}
+	for remaining != 0 {
+		m := int32(0x7fffffff)
+		for _, r := range s {
+			if m > r && r >= n {
+				m = r
+			}
+		}
+		delta += (m - n) * (h + 1)
+		if delta < 0 {
+			return "", errPunycode
+		}
+		n = m
+		for _, r := range s {
+			if r < n {
+				delta++
+				if delta < 0 {
+					return "", errPunycode
+				}
+				continue
+			}
+			if r > n {
+				continue
+			}
+			q := delta
+			for k := punycodeBase; ; k += punycodeBase {
+				t := punycodeThreshold(k, bias)
+				if q < t {
+					break
+				}
+				output = append(output, punycodeEncodeDigit(t+(q-t)%(punycodeBase-t)))
+				q = (q - t) / (punycodeBase - t)
+			}
+			output = append(output, punycodeEncodeDigit(q))
+			bias = punycodeAdapt(delta, h+1, h == b)
+			delta = 0
+			h++
+			remaining--
+		}
+		delta++
+		n++
+	}
+	return string(output), nil
+}
+
+// punycodeDecode decodes punycode (without the xn-- prefix) to a Unicode string.
+func punycodeDecode(encoded string) (string, error) {
+	pos := 1 + strings.LastIndex(encoded, "-")
+	if pos == 1 {
+		return "", errPunycode
+	}
+	if pos == len(encoded) {
+		return encoded[:len(encoded)-1], nil
+	}
+	output := make([]rune, 0, len(encoded))
+	if pos != 0 {
+		for _, r := range encoded[:pos-1] {
+			if r >= 0x80 {
+				return "", errPunycode
+			}
+			output = append(output, r)
+		}
+	}
+	i, n, bias := int32(0), punycodeInitialN, punycodeInitialBias
+	for pos < len(encoded) {
+		oldI, w := i, int32(1)
+		for k := punycodeBase; ; k += punycodeBase {
+			if pos == len(encoded) {
+				return "", errPunycode
+			}
+			digit, ok := punycodeDecodeDigit(encoded[pos])
+			if !ok {
+				return "", errPunycode
+			}
+			pos++
+			if digit > (0x7fffffff-i)/w {
+				return "", errPunycode
+			}
+			i += digit * w
+			t := punycodeThreshold(k, bias)
+			if digit < t {
+				break
+			}
+			if w > 0x7fffffff/(punycodeBase-t) {
+				return "", errPunycode
+			}
+			w *= punycodeBase - t
+		}
+		if len(output) >= 1024 {
+			return "", errPunycode
+		}
+		x := int32(len(output) + 1)
+		bias = punycodeAdapt(i-oldI, x, oldI == 0)
+		n += i / x
+		i %= x
+		if n < 0 || n > utf8.MaxRune {
+			return "", errPunycode
+		}
+		output = append(output, 0)
+		copy(output[i+1:], output[i:])
+		output[i] = n
+		i++
+	}
+	return string(output), nil
+}
+
+func punycodeThreshold(k, bias int32) int32 {
+	if k <= bias {
+		return punycodeTMin
+	} else if k >= bias+punycodeTMax {
+		return punycodeTMax
+	}
+	return k - bias
+}
+
+func punycodeAdapt(delta, numPoints int32, firstTime bool) int32 {
+	if firstTime {
+		delta /= punycodeDamp
+	} else {
+		delta /= 2
+	}
+	delta += delta / numPoints
+	k := int32(0)
+	for delta > ((punycodeBase-punycodeTMin)*punycodeTMax)/2 {
+		delta /= punycodeBase - punycodeTMin
+		k += punycodeBase
+	}
+	return k + (punycodeBase-punycodeTMin+1)*delta/(delta+punycodeSkew)
+}
+
+func punycodeEncodeDigit(digit int32) byte {
+	if digit < 26 {
+		return byte('a' + digit)
+	}
+	return byte('0' + digit - 26)
+}
+
+func punycodeDecodeDigit(c byte) (int32, bool) {
+	switch {
+	case '0' <= c && c <= '9':
+		return int32(c-'0') + 26, true
+	case 'A' <= c && c <= 'Z':
+		return int32(c - 'A'), true
+	case 'a' <= c && c <= 'z':
+		return int32(c - 'a'), true
+	}
+	return 0, false
+}
+
+// labelToASCII lowercases a domain label and converts it to its xn-- form if
+// it contains non-ASCII characters e.g. müller becomes xn--mller-kva.
+func labelToASCII(label string) (string, error) {
+	label = strings.ToLower(label)
+	for i := 0; i < len(label); i++ {
+		if label[i] >= utf8.RuneSelf {
+			encoded, err := punycodeEncode(label)
+			if err != nil {
+				return "", err
+			}
+			return acePrefix + encoded, nil
+		}
+	}
+	return label, nil
+}
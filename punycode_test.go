@@ -0,0 +1,32 @@
+package govalidator
+
+import "testing"
+
+func TestPunycode(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		decoded string
+		encoded string
+	}{
+		{"müller", "mller-kva"},
+		{"bücher", "bcher-kva"},
+		{"中文网", "fiq228c5hs"},
+		{"münchen-ost", "mnchen-ost-9db"},
+		{"abc", "abc-"},
+	}
+	for _, test := range tests {
+		actual, err := punycodeEncode(test.decoded)
+		if actual != test.encoded || err != nil {
+			t.Errorf("Expected punycodeEncode(%q) to be %v, got %v, err %v", test.decoded, test.encoded, actual, err)
+		}
+		actual, err = punycodeDecode(test.encoded)
+		if actual != test.decoded || err != nil {
+			t.Errorf("Expected punycodeDecode(%q) to be %v, got %v, err %v", test.encoded, test.decoded, actual, err)
+		}
+	}
+
+	if _, err := punycodeDecode("a!"); err == nil {
+		t.Errorf("Expected punycodeDecode(%q) to fail", "a!")
+	}
+}
@@ -0,0 +1,370 @@
+package govalidator
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// SemanticVersion is a parsed semantic version (see https://semver.org), compare versions with Compare.
+type SemanticVersion struct {
+	Major      uint64
+	Minor      uint64
+	Patch      uint64
+	Prerelease []string
+	Build      []string
+}
+
+// ParseSemver parses a semantic version e.g. 1.2.3-beta.1+exp.sha.5114f85, with an optional v prefix.
+func ParseSemver(str string) (*SemanticVersion, error) {
+	if !rxSemver.MatchString(str) {
+		return nil, errors.New("invalid semantic version " + str)
+	}
+	str = strings.TrimPrefix(str, "v")
+	v := &SemanticVersion{}
+	if i := strings.IndexByte(str, '+'); i >= 0 {
+		v.Build = strings.Split(str[i+1:], ".")
+		str = str[:i]
+	}
+	if i := strings.IndexByte(str, '-'); i >= 0 {
+		v.Prerelease = strings.Split(str[i+1:], ".")
+		str = str[:i]
+	}
+	parts := strings.Split(str, ".")
+	for i, n := range []*uint64{&v.Major, &v.Minor, &v.Patch} {
+		var err error
+		if *n, err = strconv.ParseUint(parts[i], 10, 64); err != nil {
+			return nil, errors.New("invalid semantic version " + str)
+		}
+	}
+	return v, nil
+}
+
+// String returns the version without a v prefix.
+func (v *SemanticVersion) String() string {
+	str := strconv.FormatUint(v.Major, 10) + "." + strconv.FormatUint(v.Minor, 10) + "." + strconv.FormatUint(v.Patch, 10)
+	if len(v.Prerelease) > 0 {
+		str += "-" + strings.Join(v.Prerelease, ".")
+	}
+	if len(v.Build) > 0 {
+		str += "+" + strings.Join(v.Build, ".")
+	}
+	return str
+}
+
+// Compare returns -1, 0 or 1 if v has a lower, the same or a higher precedence than other.
+// Pre-release versions have a lower precedence than the release and build metadata is ignored.
+func (v *SemanticVersion) Compare(other *SemanticVersion) int {
+	for _, pair := range [][2]uint64{{v.Major, other.Major}, {v.Minor, other.Minor}, {v.Patch, other.Patch}} {
+		if c := compareUint(pair[0], pair[1]); c != 0 {
+			return c
+		}
+	}
+	switch {
+	case len(v.Prerelease) == 0 && len(other.Prerelease) == 0:
+		return 0
+	case len(v.Prerelease) == 0:
+		return 1
+	case len(other.Prerelease) == 0:
+		return -1
+	}
+	for i := 0; i < len(v.Prerelease) && i < len(other.Prerelease); i++ {
+		if c := comparePrerelease(v.Prerelease[i], other.Prerelease[i]); c != 0 {
+			return c
+		}
+	}
+	return compareUint(uint64(len(v.Prerelease)), uint64(len(other.Prerelease)))
+}
+
+func compareUint(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// comparePrerelease compares pre-release identifiers, numeric identifiers have a lower precedence than alphanumeric ones.
+func comparePrerelease(a, b string) int {
+	an, aErr := strconv.ParseUint(a, 10, 64)
+	bn, bErr := strconv.ParseUint(b, 10, 64)
+	switch {
+	case aErr == nil && bErr == nil:
+		return compareUint(an, bn)
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+// semverComparator is a single comparison e.g. >=1.2.0.
+type semverComparator struct {
+	op      string
+	version *SemanticVersion
+}
+
+func (c semverComparator) check(v *SemanticVersion) bool {
+	cmp := v.Compare(c.version)
+	switch c.op {
+	case "=":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	}
+	return false
+}
+
+// SemverConstraint is a parsed semantic version constraint, see ParseSemverConstraint.
+type SemverConstraint struct {
+	sets [][]semverComparator
+}
+
+// ParseSemverConstraint parses a constraint made of comparators separated by spaces (or commas) which must all be
+// satisfied, optionally combining several of them with || e.g. `>=1.2.0 <2.0.0 || ^3.1`. A comparator is an operator
+// (=, !=, >, >=, <, <=, ~ or ^) followed by a version which may be partial (1.2) or use wildcards (1.x, 1.2.*),
+// a bare (partial) version e.g. 1.2 or a hyphen range e.g. 1.2 - 1.4. Like in npm, ~1.2.3 allows patch updates
+// (>=1.2.3 <1.3.0) and ^1.2.3 allows updates that don't change the leftmost non zero component (>=1.2.3 <2.0.0).
+func ParseSemverConstraint(str string) (*SemverConstraint, error) {
+	if strings.TrimSpace(str) == "" {
+		return nil, errors.New("empty semantic version constraint")
+	}
+	c := &SemverConstraint{}
+	for _, set := range strings.Split(str, "||") {
+		comparators, err := parseSemverComparators(set)
+		if err != nil {
+			return nil, err
+		}
+		c.sets = append(c.sets, comparators)
+	}
+	return c, nil
+}
+
+// Check returns true if the version satisfies the constraint.
+func (c *SemverConstraint) Check(v *SemanticVersion) bool {
+	for _, set := range c.sets {
+		ok := true
+		for _, comparator := range set {
+			if !comparator.check(v) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+func parseSemverComparators(set string) ([]semverComparator, error) {
+	var tokens []string
+	for _, field := range strings.Fields(strings.Replace(set, ",", " ", -1)) {
+		// Join operators separated from their version e.g. ">= 1.2".
+		if n := len(tokens); n > 0 && strings.Trim(tokens[n-1], "=!<>~^") == "" {
+			tokens[n-1] += field
+			continue
+		}
+		tokens = append(tokens, field)
+	}
+	if len(tokens) == 0 {
+		return nil, errors.New("empty semantic version constraint")
+	}
+	var comparators []semverComparator
+	for i := 0; i < len(tokens); i++ {
+		if i+2 < len(tokens) && tokens[i+1] == "-" {
+			from, err := parsePartialSemver(tokens[i])
+			if err != nil {
+				return nil, err
+			}
+			to, err := parsePartialSemver(tokens[i+2])
+			if err != nil {
+				return nil, err
+			}
+			comparators = append(comparators, from.lowerBound(">=")...)
+			comparators = append(comparators, to.upperBound("<=")...)
+			i += 2
+			continue
+		}
+		op := tokens[i][:len(tokens[i])-len(strings.TrimLeft(tokens[i], "=!<>~^"))]
+		version, err := parsePartialSemver(tokens[i][len(op):])
+		if err != nil {
+			return nil, err
+		}
+		desugared, err := version.comparators(op)
+		if err != nil {
+			return nil, err
+		}
+		comparators = append(comparators, desugared...)
+	}
+	return comparators, nil
+}
+
+// partialSemver is a version in a constraint, n is the number of components given e.g. 2 for 1.2 and 1.2.x.
+type partialSemver struct {
+	version SemanticVersion
+	n       int
+}
+
+func parsePartialSemver(str string) (partialSemver, error) {
+	invalid := errors.New("invalid semantic version constraint " + str)
+	str = strings.TrimPrefix(str, "v")
+	if i := strings.IndexByte(str, '+'); i >= 0 {
+		str = str[:i]
+	}
+	core, prerelease := str, ""
+	if i := strings.IndexByte(str, '-'); i >= 0 {
+		core, prerelease = str[:i], str[i+1:]
+	}
+	parts := strings.Split(core, ".")
+	if len(parts) > 3 {
+		return partialSemver{}, invalid
+	}
+	var p partialSemver
+	components := []*uint64{&p.version.Major, &p.version.Minor, &p.version.Patch}
+	wildcard := false
+	for i, part := range parts {
+		if part == "x" || part == "X" || part == "*" {
+			wildcard = true
+			continue
+		}
+		if wildcard || part == "" || (len(part) > 1 && part[0] == '0') {
+			return partialSemver{}, invalid
+		}
+		n, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return partialSemver{}, invalid
+		}
+		*components[i] = n
+		p.n++
+	}
+	if prerelease != "" {
+		if p.n != 3 || !rxSemver.MatchString(strings.Join(parts, ".")+"-"+prerelease) {
+			return partialSemver{}, invalid
+		}
+		p.version.Prerelease = strings.Split(prerelease, ".")
+	}
+	return p, nil
+}
+
+// next returns the lowest version above the versions matched by the partial version e.g. 1.3.0-0 for 1.2.
+func (p partialSemver) next() *SemanticVersion {
+	v := &SemanticVersion{Major: p.version.Major, Minor: p.version.Minor, Prerelease: []string{"0"}}
+	if p.n == 1 {
+		v.Major++
+		v.Minor = 0
+	} else {
+		v.Minor++
+	}
+	return v
+}
+
+func (p partialSemver) lowerBound(op string) []semverComparator {
+	if p.n == 0 {
+		return nil
+	}
+	if op == ">" && p.n < 3 {
+		return []semverComparator{{">=", p.next()}}
+	}
+	v := p.version
+	return []semverComparator{{op, &v}}
+}
+
+func (p partialSemver) upperBound(op string) []semverComparator {
+	if p.n == 0 {
+		return nil
+	}
+	v := p.version
+	if p.n < 3 {
+		if op == "<=" {
+			return []semverComparator{{"<", p.next()}}
+		}
+		v.Prerelease = []string{"0"}
+	}
+	return []semverComparator{{op, &v}}
+}
+
+func (p partialSemver) comparators(op string) ([]semverComparator, error) {
+	switch op {
+	case "", "=", "==":
+		if p.n == 3 {
+			v := p.version
+			return []semverComparator{{"=", &v}}, nil
+		}
+		return append(p.lowerBound(">="), p.upperBound("<=")...), nil
+	case "!=":
+		if p.n != 3 {
+			return nil, errors.New("!= requires a full semantic version")
+		}
+		v := p.version
+		return []semverComparator{{"!=", &v}}, nil
+	case ">", ">=":
+		if p.n == 0 && op == ">" {
+			return nil, errors.New("> requires a semantic version")
+		}
+		return p.lowerBound(op), nil
+	case "<", "<=":
+		if p.n == 0 && op == "<" {
+			return nil, errors.New("< requires a semantic version")
+		}
+		return p.upperBound(op), nil
+	case "~":
+		if p.n == 0 {
+			return nil, nil
+		}
+		upper := partialSemver{p.version, p.n}
+		if upper.n > 2 {
+			upper.n = 2
+		}
+		return append(p.lowerBound(">="), semverComparator{"<", upper.next()}), nil
+	case "^":
+		if p.n == 0 {
+			return nil, nil
+		}
+		// The upper bound bumps the leftmost non zero component (or the last given one).
+		upper := partialSemver{p.version, 1}
+		switch {
+		case p.version.Major == 0 && p.version.Minor == 0 && p.n == 3:
+			next := &SemanticVersion{Patch: p.version.Patch + 1, Prerelease: []string{"0"}}
+			return append(p.lowerBound(">="), semverComparator{"<", next}), nil
+		case p.version.Major == 0 && p.n >= 2:
+			upper.n = 2
+		}
+		return append(p.lowerBound(">="), semverComparator{"<", upper.next()}), nil
+	}
+	return nil, errors.New("invalid semantic version operator " + op)
+}
+
+// IsSemverConstraint checks if the string is a valid semantic version constraint (see ParseSemverConstraint).
+func IsSemverConstraint(str string) bool {
+	_, err := ParseSemverConstraint(str)
+	return err == nil
+}
+
+// IsSemverIn checks if the string is a semantic version satisfying the constraint e.g. `>=1.4 <2`.
+func IsSemverIn(str, constraint string) bool {
+	v, err := ParseSemver(str)
+	if err != nil {
+		return false
+	}
+	c, err := ParseSemverConstraint(constraint)
+	return err == nil && c.Check(v)
+}
+
+func isSemverInRaw(str string, params ...string) bool {
+	if len(params) == 1 {
+		return IsSemverIn(str, params[0])
+	}
+	return false
+}
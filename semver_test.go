@@ -0,0 +1,150 @@
+package govalidator
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseSemver(t *testing.T) {
+	t.Parallel()
+
+	v, err := ParseSemver("v1.2.3-beta.1+exp.sha.5114f85")
+	if err != nil {
+		t.Fatalf("Expected ParseSemver to succeed, got %v", err)
+	}
+	expected := &SemanticVersion{1, 2, 3, []string{"beta", "1"}, []string{"exp", "sha", "5114f85"}}
+	if !reflect.DeepEqual(v, expected) {
+		t.Errorf("Expected ParseSemver to be %+v, got %+v", expected, v)
+	}
+	if v.String() != "1.2.3-beta.1+exp.sha.5114f85" {
+		t.Errorf("Expected SemanticVersion.String() to be %q, got %q", "1.2.3-beta.1+exp.sha.5114f85", v.String())
+	}
+	if _, err := ParseSemver("1.2"); err == nil {
+		t.Error("Expected ParseSemver(\"1.2\") to fail")
+	}
+}
+
+func TestSemanticVersionCompare(t *testing.T) {
+	t.Parallel()
+
+	// Ordered by precedence, see https://semver.org/#spec-item-11
+	versions := []string{
+		"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta", "1.0.0-beta.2",
+		"1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "1.0.1", "1.2.0", "1.10.0", "2.0.0",
+	}
+	for i := range versions {
+		for j := range versions {
+			a, _ := ParseSemver(versions[i])
+			b, _ := ParseSemver(versions[j])
+			expected := compareUint(uint64(i), uint64(j))
+			if actual := a.Compare(b); actual != expected {
+				t.Errorf("Expected %s.Compare(%s) to be %d, got %d", versions[i], versions[j], expected, actual)
+			}
+		}
+	}
+
+	a, _ := ParseSemver("1.0.0+build.1")
+	b, _ := ParseSemver("1.0.0+build.2")
+	if a.Compare(b) != 0 {
+		t.Error("Expected build metadata to be ignored")
+	}
+}
+
+func TestIsSemverConstraint(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{" ", false},
+		{"*", true},
+		{"1.x", true},
+		{"1.2.*", true},
+		{"v1.2.3", true},
+		{">=1.2.0 <2.0.0 || ^3.1", true},
+		{">= 1.2.0, < 2.0.0", true},
+		{"~1.2.3", true},
+		{"1.2 - 1.4.5", true},
+		{"!=1.2.3", true},
+		{"=1.2.3-beta.1", true},
+		{">=1.2.0 ||", false},
+		{"!=1.2", false},
+		{">*", false},
+		{"1.x.3", false},
+		{"01.2", false},
+		{"1.2.3.4", false},
+		{"1.2-beta", false},
+		{"=>1.2", false},
+		{"abc", false},
+		{"1.2 -", false},
+	}
+	for _, test := range tests {
+		actual := IsSemverConstraint(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsSemverConstraint(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestIsSemverIn(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param      string
+		constraint string
+		expected   bool
+	}{
+		{"1.5.0", ">=1.4 <2", true},
+		{"1.4.0", ">=1.4 <2", true},
+		{"1.3.9", ">=1.4 <2", false},
+		{"2.0.0", ">=1.4 <2", false},
+		{"2.0.0-rc.1", ">=1.4 <2", false},
+		{"3.2.0", ">=1.2.0 <2.0.0 || ^3.1", true},
+		{"4.0.0", ">=1.2.0 <2.0.0 || ^3.1", false},
+		{"1.2.9", "~1.2.3", true},
+		{"1.3.0", "~1.2.3", false},
+		{"1.9.0", "~1", true},
+		{"1.9.0", "^1.2.3", true},
+		{"2.0.0", "^1.2.3", false},
+		{"0.2.9", "^0.2.3", true},
+		{"0.3.0", "^0.2.3", false},
+		{"0.0.3", "^0.0.3", true},
+		{"0.0.4", "^0.0.3", false},
+		{"1.2.7", "1.2", true},
+		{"1.3.0", "1.2.x", false},
+		{"1.4.9", "1.2 - 1.4", true},
+		{"1.5.0", "1.2 - 1.4", false},
+		{"1.2.4", "!=1.2.3", true},
+		{"1.2.3", "!=1.2.3", false},
+		{"1.2.3", ">1.2", false},
+		{"1.3.0", ">1.2", true},
+		{"1.2.0", "<=1.2", true},
+		{"1.3.0", "<=1.2", false},
+		{"9.9.9", "*", true},
+		{"v1.5.0", ">=1.4", true},
+		{"1.5", ">=1.4", false},
+		{"1.5.0", ">=1.4 ||", false},
+	}
+	for _, test := range tests {
+		actual := IsSemverIn(test.param, test.constraint)
+		if actual != test.expected {
+			t.Errorf("Expected IsSemverIn(%q, %q) to be %v, got %v", test.param, test.constraint, test.expected, actual)
+		}
+	}
+}
+
+func TestValidateSemverConstraint(t *testing.T) {
+	type Plugin struct {
+		Version  string `valid:"semver_in(>=1.4 <2 || ^3)"`
+		Requires string `valid:"semver_constraint"`
+	}
+
+	if ok, err := Validate(Plugin{"3.1.0", "^1.2 || >=2.1.0 <3"}); !ok {
+		t.Errorf("Expected plugin to pass, got %v", err)
+	}
+	if ok, err := Validate(Plugin{"2.1.0", ">=1.2 <"}); ok {
+		t.Errorf("Expected plugin to fail, got %v", err)
+	}
+}
@@ -0,0 +1,408 @@
+package govalidator
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// vatRule is the format of the number (without the country prefix) of a VAT
+// identification number and its check digit algorithm.
+type vatRule struct {
+	format *regexp.Regexp
+	check  func(number string) bool
+}
+
+// vatRules maps the VAT prefixes of the EU member states (EL is Greece and XI Northern Ireland) to their rules.
+var vatRules = map[string]vatRule{
+	"AT": {regexp.MustCompile(`^U\d{8}$`), checkATVAT},
+	"BE": {regexp.MustCompile(`^[01]\d{9}$`), checkBEVAT},
+	"BG": {regexp.MustCompile(`^\d{9,10}$`), checkBGVAT},
+	"CY": {regexp.MustCompile(`^[013-59]\d{7}[A-Z]$`), checkCYVAT},
+	"CZ": {regexp.MustCompile(`^\d{8,10}$`), checkCZVAT},
+	"DE": {regexp.MustCompile(`^\d{9}$`), checkISO7064Mod11_10},
+	"DK": {regexp.MustCompile(`^\d{8}$`), checkDKVAT},
+	"EE": {regexp.MustCompile(`^10\d{7}$`), checkEEVAT},
+	"EL": {regexp.MustCompile(`^\d{9}$`), checkELVAT},
+	"ES": {regexp.MustCompile(`^[A-Z0-9]\d{7}[A-Z0-9]$`), checkESVAT},
+	"FI": {regexp.MustCompile(`^\d{8}$`), checkFIVAT},
+	"FR": {regexp.MustCompile(`^[0-9A-HJ-NP-Z]{2}\d{9}$`), checkFRVAT},
+	"HR": {regexp.MustCompile(`^\d{11}$`), checkISO7064Mod11_10},
+	"HU": {regexp.MustCompile(`^\d{8}$`), checkHUVAT},
+	"IE": {regexp.MustCompile(`^\d{7}[A-W][A-IW]?$`), checkIEVAT},
+	"IT": {regexp.MustCompile(`^\d{11}$`), checkLuhn},
+	"LT": {regexp.MustCompile(`^(?:\d{9}|\d{12})$`), checkLTVAT},
+	"LU": {regexp.MustCompile(`^\d{8}$`), checkLUVAT},
+	"LV": {regexp.MustCompile(`^\d{11}$`), checkLVVAT},
+	"MT": {regexp.MustCompile(`^[1-9]\d{7}$`), checkMTVAT},
+	"NL": {regexp.MustCompile(`^\d{9}B\d{2}$`), checkNLVAT},
+	"PL": {regexp.MustCompile(`^\d{10}$`), checkPLVAT},
+	"PT": {regexp.MustCompile(`^\d{9}$`), checkPTVAT},
+	"RO": {regexp.MustCompile(`^[1-9]\d{1,9}$`), checkROVAT},
+	"SE": {regexp.MustCompile(`^\d{10}01$`), func(number string) bool { return checkLuhn(number[:10]) }},
+	"SI": {regexp.MustCompile(`^[1-9]\d{7}$`), checkSIVAT},
+	"SK": {regexp.MustCompile(`^[1-9]\d{9}$`), checkSKVAT},
+	"XI": {regexp.MustCompile(`^(?:\d{9}|\d{12}|GD[0-4]\d{2}|HA[5-9]\d{2})$`), checkGBVAT},
+}
+
+var taxIDSeparators = strings.NewReplacer(" ", "", "-", "", ".", "", "/", "")
+
+// IsVAT checks if the string is an EU VAT identification number with its country prefix e.g. DE136695976,
+// using the check digits of the country. Spaces, hyphens and dots are ignored.
+func IsVAT(str string) bool {
+	vat := strings.ToUpper(taxIDSeparators.Replace(str))
+	if len(vat) < 4 {
+		return false
+	}
+	rule, ok := vatRules[vat[:2]]
+	if !ok || !rule.format.MatchString(vat[2:]) {
+		return false
+	}
+	return rule.check(vat[2:])
+}
+
+// IsNINO checks if the string is a UK National Insurance number e.g. AB 12 34 56 C.
+func IsNINO(str string) bool {
+	nino := strings.ToUpper(strings.Replace(str, " ", "", -1))
+	if !rxNINO.MatchString(nino) {
+		return false
+	}
+	switch nino[:2] {
+	case "BG", "GB", "KN", "NK", "NT", "TN", "ZZ":
+		return false
+	}
+	return true
+}
+
+// IsSIN checks if the string is a Canadian Social Insurance Number e.g. 130 692 544, using its Luhn check digit.
+func IsSIN(str string) bool {
+	sin := taxIDSeparators.Replace(str)
+	return len(sin) == 9 && IsNumeric(sin) && sin[0] != '0' && sin[0] != '8' && checkLuhn(sin)
+}
+
+// IsCPF checks if the string is a Brazilian individual taxpayer number (CPF) e.g. 529.982.247-25.
+func IsCPF(str string) bool {
+	cpf := taxIDSeparators.Replace(str)
+	if len(cpf) != 11 || !IsNumeric(cpf) || strings.Count(cpf, cpf[:1]) == 11 {
+		return false
+	}
+	return checkCPFDigit(cpf[:9]) == cpf[9] && checkCPFDigit(cpf[:10]) == cpf[10]
+}
+
+func checkCPFDigit(digits string) byte {
+	sum := 0
+	for i, c := range digits {
+		sum += int(c-'0') * (len(digits) + 1 - i)
+	}
+	return byte('0' + sum*10%11%10)
+}
+
+// IsCNPJ checks if the string is a Brazilian company taxpayer number (CNPJ) e.g. 11.222.333/0001-81.
+func IsCNPJ(str string) bool {
+	cnpj := taxIDSeparators.Replace(str)
+	if len(cnpj) != 14 || !IsNumeric(cnpj) || strings.Count(cnpj, cnpj[:1]) == 14 {
+		return false
+	}
+	return checkCNPJDigit(cnpj[:12]) == cnpj[12] && checkCNPJDigit(cnpj[:13]) == cnpj[13]
+}
+
+func checkCNPJDigit(digits string) byte {
+	sum := 0
+	for i, c := range digits {
+		weight := (len(digits)-1-i)%8 + 2
+		sum += int(c-'0') * weight
+	}
+	if r := sum % 11; r >= 2 {
+		return byte('0' + 11 - r)
+	}
+	return '0'
+}
+
+// IsPAN checks if the string is an Indian Permanent Account Number e.g. ABCPE1234F.
+func IsPAN(str string) bool {
+	return rxPAN.MatchString(str)
+}
+
+// IsDNI checks if the string is a Spanish national identity number (DNI) e.g. 12345678Z, using its check letter.
+func IsDNI(str string) bool {
+	dni := strings.ToUpper(taxIDSeparators.Replace(str))
+	return rxDNI.MatchString(dni) && checkSpanishIDLetter(dni[:8], dni[8])
+}
+
+// IsNIE checks if the string is a Spanish foreigner identity number (NIE) e.g. X1234567L, using its check letter.
+func IsNIE(str string) bool {
+	nie := strings.ToUpper(taxIDSeparators.Replace(str))
+	if !rxNIE.MatchString(nie) {
+		return false
+	}
+	number := strconv.Itoa(strings.IndexByte("XYZ", nie[0])) + nie[1:8]
+	return checkSpanishIDLetter(number, nie[8])
+}
+
+func checkSpanishIDLetter(number string, letter byte) bool {
+	n, err := strconv.Atoi(number)
+	return err == nil && "TRWAGMYFPDXBNJZSQVHLCKE"[n%23] == letter
+}
+
+// checkLuhn checks the digits have a valid Luhn (mod 10) check digit.
+func checkLuhn(digits string) bool {
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			if d *= 2; d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// checkISO7064Mod11_10 checks the last digit is the ISO 7064 MOD 11,10 check digit (used by DE and HR).
+func checkISO7064Mod11_10(digits string) bool {
+	product := 10
+	for _, c := range digits[:len(digits)-1] {
+		sum := (int(c-'0') + product) % 10
+		if sum == 0 {
+			sum = 10
+		}
+		product = 2 * sum % 11
+	}
+	check := 11 - product
+	if check == 10 {
+		check = 0
+	}
+	return check == int(digits[len(digits)-1]-'0')
+}
+
+// checkWeighted returns the sum of the digits multiplied by their weights.
+func checkWeighted(digits string, weights ...int) int {
+	sum := 0
+	for i, w := range weights {
+		sum += int(digits[i]-'0') * w
+	}
+	return sum
+}
+
+func checkATVAT(number string) bool {
+	sum := 0
+	for i, c := range number[1:8] {
+		d := int(c - '0')
+		if i%2 == 1 {
+			d = d*2/10 + d*2%10
+		}
+		sum += d
+	}
+	return (10-(sum+4)%10)%10 == int(number[8]-'0')
+}
+
+func checkBEVAT(number string) bool {
+	n, _ := strconv.Atoi(number[:8])
+	check, _ := strconv.Atoi(number[8:])
+	return 97-n%97 == check
+}
+
+func checkDKVAT(number string) bool {
+	return checkWeighted(number, 2, 7, 6, 5, 4, 3, 2, 1)%11 == 0
+}
+
+func checkELVAT(number string) bool {
+	return checkWeighted(number, 256, 128, 64, 32, 16, 8, 4, 2)%11%10 == int(number[8]-'0')
+}
+
+// checkESVAT checks the number is a valid NIF of a person (DNI or NIE) or a company (CIF).
+func checkESVAT(number string) bool {
+	if IsDNI(number) || IsNIE(number) {
+		return true
+	}
+	if !strings.ContainsRune("ABCDEFGHJNPQRSUVW", rune(number[0])) || !IsNumeric(number[1:8]) {
+		return false
+	}
+	sum := 0
+	for i, c := range number[1:8] {
+		d := int(c - '0')
+		if i%2 == 0 {
+			d = d*2/10 + d*2%10
+		}
+		sum += d
+	}
+	digit := (10 - sum%10) % 10
+	return number[8] == byte('0'+digit) || number[8] == "JABCDEFGHI"[digit]
+}
+
+func checkFIVAT(number string) bool {
+	r := checkWeighted(number, 7, 9, 10, 5, 8, 4, 2) % 11
+	if r == 1 {
+		return false
+	}
+	return (11-r)%11 == int(number[7]-'0')
+}
+
+func checkFRVAT(number string) bool {
+	key, err := strconv.Atoi(number[:2])
+	if err != nil {
+		// Newer alphanumeric keys have no published algorithm.
+		return true
+	}
+	siren, _ := strconv.Atoi(number[2:])
+	return (12+3*(siren%97))%97 == key
+}
+
+func checkIEVAT(number string) bool {
+	sum := checkWeighted(number, 8, 7, 6, 5, 4, 3, 2)
+	if len(number) == 9 && number[8] != 'W' {
+		sum += 9 * int(number[8]-'A'+1)
+	}
+	return "WABCDEFGHIJKLMNOPQRSTUV"[sum%23] == number[7]
+}
+
+func checkLUVAT(number string) bool {
+	n, _ := strconv.Atoi(number[:6])
+	check, _ := strconv.Atoi(number[6:])
+	return n%89 == check
+}
+
+// checkNLVAT checks the number of a company (mod 11) or a sole trader (mod 97, since 2020).
+func checkNLVAT(number string) bool {
+	r := checkWeighted(number, 9, 8, 7, 6, 5, 4, 3, 2) % 11
+	if r != 10 && r == int(number[8]-'0') {
+		return true
+	}
+	return ibanMod97("NL"+number) == 1
+}
+
+func checkPLVAT(number string) bool {
+	r := checkWeighted(number, 6, 5, 7, 2, 3, 4, 5, 6, 7) % 11
+	return r != 10 && r == int(number[9]-'0')
+}
+
+func checkPTVAT(number string) bool {
+	check := 11 - checkWeighted(number, 9, 8, 7, 6, 5, 4, 3, 2)%11
+	if check >= 10 {
+		check = 0
+	}
+	return check == int(number[8]-'0')
+}
+
+// checkBGVAT checks the number of a legal entity (9 digits) or of a person (10 digits): a Bulgarian personal
+// number (EGN), a foreigner's number (PNF) or another taxpayer's number.
+func checkBGVAT(number string) bool {
+	if len(number) == 9 {
+		check := checkWeighted(number, 1, 2, 3, 4, 5, 6, 7, 8) % 11
+		if check == 10 {
+			check = checkWeighted(number, 3, 4, 5, 6, 7, 8, 9, 10) % 11
+		}
+		return check%10 == int(number[8]-'0')
+	}
+	last := int(number[9] - '0')
+	return checkWeighted(number, 2, 4, 8, 5, 10, 9, 7, 3, 6)%11%10 == last ||
+		checkWeighted(number, 21, 19, 17, 13, 11, 9, 7, 3, 1)%10 == last ||
+		(11-checkWeighted(number, 4, 3, 2, 7, 6, 5, 4, 3, 2)%11)%11%10 == last
+}
+
+func checkCYVAT(number string) bool {
+	if number[:2] == "12" {
+		return false
+	}
+	sum := 0
+	for i, c := range number[:8] {
+		d := int(c - '0')
+		if i%2 == 0 {
+			d = []int{1, 0, 5, 7, 9, 13, 15, 17, 19, 21}[d]
+		}
+		sum += d
+	}
+	return number[8] == byte('A'+sum%26)
+}
+
+// checkCZVAT checks the number of a legal entity (8 digits), an individual without a birth number (9 digits starting
+// with 6) or the birth number of a person (9 digits before 1954, which have no check digit, or 10 digits).
+func checkCZVAT(number string) bool {
+	switch {
+	case len(number) == 8:
+		check := (11 - checkWeighted(number, 8, 7, 6, 5, 4, 3, 2)%11) % 11
+		if check == 0 {
+			check = 1
+		}
+		return number[0] != '9' && check%10 == int(number[7]-'0')
+	case len(number) == 9 && number[0] == '6':
+		check := checkWeighted(number[1:], 8, 7, 6, 5, 4, 3, 2) % 11
+		return 9-(11-check)%10 == int(number[8]-'0')
+	case len(number) == 9:
+		return true
+	}
+	n, _ := strconv.ParseInt(number[:9], 10, 64)
+	return n%11%10 == int64(number[9]-'0')
+}
+
+func checkEEVAT(number string) bool {
+	return (10-checkWeighted(number, 3, 7, 1, 3, 7, 1, 3, 7)%10)%10 == int(number[8]-'0')
+}
+
+func checkHUVAT(number string) bool {
+	return checkWeighted(number, 9, 7, 3, 1, 9, 7, 3, 1)%10 == 0
+}
+
+// checkLTVAT checks the number of a legal entity (9 digits) or a temporary taxpayer (12 digits).
+func checkLTVAT(number string) bool {
+	if number[len(number)-2] != '1' {
+		return false
+	}
+	digits := number[:len(number)-1]
+	check := 0
+	for i, c := range digits {
+		check += (1 + i%9) * int(c-'0')
+	}
+	if check %= 11; check == 10 {
+		check = 0
+		for i, c := range digits {
+			check += (1 + (i+2)%9) * int(c-'0')
+		}
+		check %= 11
+	}
+	return check%10 == int(number[len(number)-1]-'0')
+}
+
+// checkLVVAT checks the number of a legal entity (starting with 4 to 9) or the personal code of a person.
+func checkLVVAT(number string) bool {
+	if number[0] > '3' {
+		return checkWeighted(number, 9, 1, 4, 8, 3, 10, 2, 5, 7, 6, 1)%11 == 3
+	}
+	return (1+checkWeighted(number, 10, 5, 8, 4, 2, 1, 6, 3, 7, 9))%11%10 == int(number[10]-'0')
+}
+
+func checkMTVAT(number string) bool {
+	return checkWeighted(number, 3, 4, 6, 7, 8, 9, 10, 1)%37 == 0
+}
+
+func checkROVAT(number string) bool {
+	padded := strings.Repeat("0", 10-len(number)) + number
+	return 10*checkWeighted(padded, 7, 5, 3, 2, 1, 7, 5, 3, 2)%11%10 == int(padded[9]-'0')
+}
+
+func checkSIVAT(number string) bool {
+	check := 11 - checkWeighted(number, 8, 7, 6, 5, 4, 3, 2)%11
+	if check == 10 {
+		check = 0
+	}
+	return check == int(number[7]-'0')
+}
+
+func checkSKVAT(number string) bool {
+	n, _ := strconv.ParseInt(number, 10, 64)
+	return strings.IndexByte("234789", number[2]) >= 0 && n%11 == 0
+}
+
+// checkGBVAT checks the mod 97 check digits of a UK (XI for Northern Ireland) number of 9 digits, or 12 digits
+// with a branch suffix, either the original scheme or the 9755 scheme of newer numbers. Government departments
+// (GD) and health authorities (HA) have no check digits.
+func checkGBVAT(number string) bool {
+	if number[0] == 'G' || number[0] == 'H' {
+		return true
+	}
+	r := checkWeighted(number, 8, 7, 6, 5, 4, 3, 2, 10, 1) % 97
+	return r == 0 || r == 42
+}
@@ -0,0 +1,244 @@
+package govalidator
+
+import "testing"
+
+func TestIsVAT(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"DE", false},
+		{"DE136695976", true},
+		{"DE 136 695 976", true},
+		{"de136695976", true},
+		{"DE136695977", false},
+		{"ATU13585627", true},
+		{"ATU13585628", false},
+		{"BE0411905847", true},
+		{"BE0411905848", false},
+		{"BG175074752", true},
+		{"BG175074753", false},
+		{"BG7523169263", true},
+		{"BG7523169264", false},
+		{"CY10259033P", true},
+		{"CY10259033Q", false},
+		{"CY20259033U", false},
+		{"CZ25123891", true},
+		{"CZ25123892", false},
+		{"CZ640903926", true},
+		{"CZ640903927", false},
+		{"CZ600000008", true},
+		{"CZ600000009", false},
+		{"CZ670000009", true},
+		{"CZ670000000", false},
+		{"CZ7103192745", true},
+		{"CZ7103192746", false},
+		{"DK13585628", true},
+		{"DK13585629", false},
+		{"EE100931558", true},
+		{"EE100931559", false},
+		{"EL094259216", true},
+		{"EL094259217", false},
+		{"ESA58818501", true},
+		{"ESA58818502", false},
+		{"ES12345678Z", true},
+		{"ESX1234567L", true},
+		{"FI20774740", true},
+		{"FI20774741", false},
+		{"FR40303265045", true},
+		{"FR41303265045", false},
+		{"HR33392005961", true},
+		{"HR33392005962", false},
+		{"HU12892312", true},
+		{"HU12892313", false},
+		{"IE6433435F", true},
+		{"IE6433435E", false},
+		{"IT00743110157", true},
+		{"IT00743110158", false},
+		{"LT119511515", true},
+		{"LT119511516", false},
+		{"LT100001919017", true},
+		{"LT100001919018", false},
+		{"LU15027442", true},
+		{"LU15027443", false},
+		{"LV40003521600", true},
+		{"LV40003521601", false},
+		{"LV16117519997", true},
+		{"LV16117519998", false},
+		{"MT11679112", true},
+		{"MT11679113", false},
+		{"NL004495445B01", true},
+		{"NL000099998B57", true},
+		{"NL004495446B01", false},
+		{"PL8567346215", true},
+		{"PL8567346216", false},
+		{"PT501964843", true},
+		{"PT501964844", false},
+		{"RO18547290", true},
+		{"RO18547291", false},
+		{"SE556188840401", true},
+		{"SE556188840501", false},
+		{"SI50223054", true},
+		{"SI50223055", false},
+		{"SK2022749619", true},
+		{"SK2022749610", false},
+		{"XI980780684", true},
+		{"XI980780685", false},
+		{"XI802311782", true},
+		{"XI802311781", false},
+		{"XIGD001", true},
+		{"XIHA500", true},
+		{"GR094259216", false},
+		{"US123456789", false},
+	}
+	for _, test := range tests {
+		actual := IsVAT(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsVAT(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestIsNINO(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"AB123456C", true},
+		{"AB 12 34 56 C", true},
+		{"ab123456c", true},
+		{"AB123456E", false},
+		{"GB123456A", false},
+		{"DA123456A", false},
+		{"AO123456A", false},
+		{"AB12345A", false},
+	}
+	for _, test := range tests {
+		actual := IsNINO(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsNINO(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestIsSIN(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"130692544", true},
+		{"130 692 544", true},
+		{"130-692-544", true},
+		{"130692545", false},
+		{"046454286", false},
+		{"13069254", false},
+	}
+	for _, test := range tests {
+		actual := IsSIN(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsSIN(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestIsCPF(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"529.982.247-25", true},
+		{"52998224725", true},
+		{"529.982.247-26", false},
+		{"111.111.111-11", false},
+		{"5299822472", false},
+	}
+	for _, test := range tests {
+		actual := IsCPF(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsCPF(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestIsCNPJ(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"11.222.333/0001-81", true},
+		{"11222333000181", true},
+		{"11.222.333/0001-82", false},
+		{"00.000.000/0000-00", false},
+		{"1122233300018", false},
+	}
+	for _, test := range tests {
+		actual := IsCNPJ(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsCNPJ(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestIsPAN(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"ABCPE1234F", true},
+		{"AAACB1234C", true},
+		{"ABCDE1234F", false},
+		{"ABCPE12345", false},
+		{"abcpe1234f", false},
+	}
+	for _, test := range tests {
+		actual := IsPAN(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsPAN(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestIsDNIAndNIE(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param string
+		dni   bool
+		nie   bool
+	}{
+		{"", false, false},
+		{"12345678Z", true, false},
+		{"12345678-z", true, false},
+		{"12345678A", false, false},
+		{"X1234567L", false, true},
+		{"Y1234567X", false, true},
+		{"X1234567A", false, false},
+		{"1234567Z", false, false},
+	}
+	for _, test := range tests {
+		if actual := IsDNI(test.param); actual != test.dni {
+			t.Errorf("Expected IsDNI(%q) to be %v, got %v", test.param, test.dni, actual)
+		}
+		if actual := IsNIE(test.param); actual != test.nie {
+			t.Errorf("Expected IsNIE(%q) to be %v, got %v", test.param, test.nie, actual)
+		}
+	}
+}
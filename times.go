@@ -0,0 +1,104 @@
+package govalidator
+
+import "time"
+
+// IsPast check if the timestamp (see ToTime for the supported formats) is in the past.
+func IsPast(str string) bool {
+	t, err := ToTime(str)
+	return err == nil && t.Before(time.Now())
+}
+
+// IsFuture check if the timestamp (see ToTime for the supported formats) is in the future.
+func IsFuture(str string) bool {
+	t, err := ToTime(str)
+	return err == nil && t.After(time.Now())
+}
+
+// IsWeekday check if the timestamp falls on a Monday to Friday (in its own time zone).
+func IsWeekday(str string) bool {
+	t, err := ToTime(str)
+	if err != nil {
+		return false
+	}
+	return t.Weekday() != time.Saturday && t.Weekday() != time.Sunday
+}
+
+// IsNotZeroTime check if the timestamp isn't the zero time i.e. 0001-01-01T00:00:00Z.
+func IsNotZeroTime(str string) bool {
+	t, err := ToTime(str)
+	return err == nil && !t.IsZero()
+}
+
+// IsAfter check if the timestamp is after the time param e.g. after(2020-01-01)
+func IsAfter(str string, params ...string) bool {
+	t, p, ok := toTimeAndParam(str, params...)
+	return ok && t.After(p)
+}
+
+// IsBefore check if the timestamp is before the time param e.g. before(2020-01-01T12:00:00Z)
+func IsBefore(str string, params ...string) bool {
+	t, p, ok := toTimeAndParam(str, params...)
+	return ok && t.Before(p)
+}
+
+// IsWithin check if the timestamp is within the duration param of now, in the past or the future e.g. within(720h)
+func IsWithin(str string, params ...string) bool {
+	if len(params) != 1 {
+		return false
+	}
+	t, err := ToTime(str)
+	if err != nil {
+		return false
+	}
+	d, err := time.ParseDuration(params[0])
+	if err != nil {
+		return false
+	}
+	diff := time.Since(t)
+	if diff < 0 {
+		diff = -diff
+	}
+	return diff <= d
+}
+
+// MinDuration check if the duration (e.g. a time.Duration field or a string such as 90s) is at least the param e.g. mindur(1s)
+func MinDuration(str string, params ...string) bool {
+	d, p, ok := toDurationAndParam(str, params...)
+	return ok && d >= p
+}
+
+// MaxDuration check if the duration (e.g. a time.Duration field or a string such as 90s) is at most the param e.g. maxdur(1h)
+func MaxDuration(str string, params ...string) bool {
+	d, p, ok := toDurationAndParam(str, params...)
+	return ok && d <= p
+}
+
+func toTimeAndParam(str string, params ...string) (time.Time, time.Time, bool) {
+	if len(params) != 1 {
+		return time.Time{}, time.Time{}, false
+	}
+	t, err := ToTime(str)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+	p, err := ToTime(params[0])
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+	return t, p, true
+}
+
+func toDurationAndParam(str string, params ...string) (time.Duration, time.Duration, bool) {
+	if len(params) != 1 {
+		return 0, 0, false
+	}
+	d, err := time.ParseDuration(str)
+	if err != nil {
+		return 0, 0, false
+	}
+	p, err := time.ParseDuration(params[0])
+	if err != nil {
+		return 0, 0, false
+	}
+	return d, p, true
+}
@@ -0,0 +1,202 @@
+package govalidator
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsPastAndFuture(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param  string
+		past   bool
+		future bool
+	}{
+		{"2001-02-03T04:05:06Z", true, false},
+		{"2001-02-03T04:05:06.789+01:00", true, false},
+		{"2001-02-03T04:05:06", true, false},
+		{"2001-02-03", true, false},
+		{"3001-02-03", false, true},
+		{time.Now().Add(time.Hour).Format(time.RFC3339), false, true},
+		{time.Now().Add(-time.Hour).Format(time.RFC3339), true, false},
+		{"03/02/2001", false, false},
+		{"", false, false},
+	}
+	for _, test := range tests {
+		if actual := IsPast(test.param); actual != test.past {
+			t.Errorf("Expected IsPast(%q) to be %v, got %v", test.param, test.past, actual)
+		}
+		if actual := IsFuture(test.param); actual != test.future {
+			t.Errorf("Expected IsFuture(%q) to be %v, got %v", test.param, test.future, actual)
+		}
+	}
+}
+
+func TestIsWeekday(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"2020-01-06", true},
+		{"2020-01-10T23:59:59Z", true},
+		{"2020-01-11", false},
+		{"2020-01-12T12:00:00+02:00", false},
+		{"2020-01-13T00:30:00+02:00", true},
+		{"monday", false},
+	}
+	for _, test := range tests {
+		actual := IsWeekday(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsWeekday(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestIsNotZeroTime(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"0001-01-01T00:00:00Z", false},
+		{"0001-01-01", false},
+		{"2020-01-01", true},
+		{"", false},
+	}
+	for _, test := range tests {
+		actual := IsNotZeroTime(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsNotZeroTime(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestIsAfterAndBefore(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param  string
+		time   string
+		after  bool
+		before bool
+	}{
+		{"2020-01-02", "2020-01-01", true, false},
+		{"2019-12-31T23:59:59Z", "2020-01-01", false, true},
+		{"2020-01-01T00:00:00Z", "2020-01-01", false, false},
+		{"2020-01-01T00:30:00+01:00", "2020-01-01T00:00:00Z", false, true},
+		{"2020-01-01T12:00:00", "2020-01-01T11:59:59.999Z", true, false},
+		{"2020-01-02", "yesterday", false, false},
+		{"tomorrow", "2020-01-01", false, false},
+	}
+	for _, test := range tests {
+		if actual := IsAfter(test.param, test.time); actual != test.after {
+			t.Errorf("Expected IsAfter(%q, %q) to be %v, got %v", test.param, test.time, test.after, actual)
+		}
+		if actual := IsBefore(test.param, test.time); actual != test.before {
+			t.Errorf("Expected IsBefore(%q, %q) to be %v, got %v", test.param, test.time, test.before, actual)
+		}
+	}
+}
+
+func TestIsWithin(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		duration string
+		expected bool
+	}{
+		{time.Now().Add(-time.Hour).Format(time.RFC3339), "2h", true},
+		{time.Now().Add(time.Hour).Format(time.RFC3339), "2h", true},
+		{time.Now().Add(-3 * time.Hour).Format(time.RFC3339), "2h", false},
+		{time.Now().Add(3 * time.Hour).Format(time.RFC3339), "2h", false},
+		{"2001-01-01", "720h", false},
+		{time.Now().Format(time.RFC3339), "2 hours", false},
+	}
+	for _, test := range tests {
+		actual := IsWithin(test.param, test.duration)
+		if actual != test.expected {
+			t.Errorf("Expected IsWithin(%q, %q) to be %v, got %v", test.param, test.duration, test.expected, actual)
+		}
+	}
+}
+
+func TestMinAndMaxDuration(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		duration string
+		min      bool
+		max      bool
+	}{
+		{"1s", "1s", true, true},
+		{"1h0m0s", "30m", true, false},
+		{"500ms", "1s", false, true},
+		{"-1s", "0s", false, true},
+		{"90", "1s", false, false},
+		{"1s", "1 second", false, false},
+	}
+	for _, test := range tests {
+		if actual := MinDuration(test.param, test.duration); actual != test.min {
+			t.Errorf("Expected MinDuration(%q, %q) to be %v, got %v", test.param, test.duration, test.min, actual)
+		}
+		if actual := MaxDuration(test.param, test.duration); actual != test.max {
+			t.Errorf("Expected MaxDuration(%q, %q) to be %v, got %v", test.param, test.duration, test.max, actual)
+		}
+	}
+}
+
+type Booking struct {
+	CreatedAt time.Time     `valid:"past" json:"created_at"`
+	StartsAt  time.Time     `valid:"required,future,within(8760h)" json:"starts_at"`
+	EndsAt    *time.Time    `valid:"after(2020-01-01),weekday" json:"ends_at"`
+	CancelBy  time.Time     `valid:"optional,before(2100-01-01T00:00:00Z)" json:"cancel_by"`
+	Length    time.Duration `valid:"mindur(30m),maxdur(8h)" json:"length"`
+	Reminders []time.Time   `valid:"notzero" json:"reminders"`
+}
+
+func TestValidateTimeFields(t *testing.T) {
+	endsAt := time.Date(2020, time.January, 6, 12, 0, 0, 0, time.UTC)
+	booking := Booking{
+		CreatedAt: time.Now().Add(-time.Minute),
+		StartsAt:  time.Now().Add(24 * time.Hour),
+		EndsAt:    &endsAt,
+		Length:    2 * time.Hour,
+		Reminders: []time.Time{endsAt},
+	}
+
+	valid, errs := Validate(booking)
+
+	assert.True(t, valid)
+	jsonBytes, _ := json.Marshal(errs)
+	assert.JSONEq(t, `{"errors":{}}`, string(jsonBytes))
+
+	endsAt = time.Date(2020, time.January, 4, 12, 0, 0, 0, time.UTC)
+	booking = Booking{
+		CreatedAt: time.Date(3000, time.January, 1, 0, 0, 0, 0, time.UTC),
+		EndsAt:    &endsAt,
+		Length:    9 * time.Hour,
+		Reminders: []time.Time{{}},
+	}
+
+	valid, errs = Validate(booking)
+
+	assert.False(t, valid)
+	jsonBytes, _ = json.Marshal(errs)
+	expectedJSON := `{"errors":{
+		"created_at":["3000-01-01T00:00:00Z does not validate as past"],
+		"starts_at":["non zero value required","0001-01-01T00:00:00Z does not validate as future","0001-01-01T00:00:00Z does not validate as within(8760h)"],
+		"ends_at":["2020-01-04T12:00:00Z does not validate as weekday"],
+		"length":["9h0m0s does not validate as maxdur(8h)"],
+		"reminders":["0001-01-01T00:00:00Z does not validate as notzero"]
+	}}`
+	assert.JSONEq(t, expectedJSON, string(jsonBytes))
+}
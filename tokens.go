@@ -0,0 +1,115 @@
+package govalidator
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// maxKSUID is the largest KSUID, 2^160 - 1 in base62.
+const maxKSUID = "aWgEPTl1tmebfsQzFP4bxwgy80V"
+
+// nanoIDAlphabet is the default alphabet of Nano IDs.
+const nanoIDAlphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_-"
+
+// IsULID check if the string is a ULID e.g. 01ARZ3NDEKTSV4RRFFQ69G5FAV (Crockford's base32, case insensitive).
+func IsULID(str string) bool {
+	return rxULID.MatchString(str)
+}
+
+// IsKSUID check if the string is a K-Sortable Unique IDentifier e.g. 0ujtsYcgvSTl8PAuAdqWYSMnLOv.
+func IsKSUID(str string) bool {
+	return rxKSUID.MatchString(str) && str <= maxKSUID
+}
+
+// IsSnowflake check if the string is a Snowflake ID (as used by Twitter and Discord) i.e. a non zero 64 bit unsigned integer.
+func IsSnowflake(str string) bool {
+	if str == "" || str[0] == '0' {
+		return false
+	}
+	_, err := strconv.ParseUint(str, 10, 64)
+	return err == nil
+}
+
+// IsNanoID check if the string is a Nano ID of the given length (21 by default) using the default alphabet.
+func IsNanoID(str string, params ...string) bool {
+	length := 21
+	if len(params) == 1 {
+		n, err := strconv.Atoi(params[0])
+		if err != nil {
+			return false
+		}
+		length = n
+	}
+	if len(str) != length {
+		return false
+	}
+	for i := 0; i < len(str); i++ {
+		if strings.IndexByte(nanoIDAlphabet, str[i]) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func isNanoID(str string) bool {
+	return IsNanoID(str)
+}
+
+// IsJWT check if the string is a JSON Web Token in its compact form: a base64url encoded JSON header
+// with an `alg`, a base64url encoded JSON payload and a base64url encoded signature
+// (which may only be empty for the `none` algorithm). The signature is not verified.
+func IsJWT(str string) bool {
+	_, ok := jwtAlgorithm(str)
+	return ok
+}
+
+// IsJWTAlgorithm check if the string is a JSON Web Token (see IsJWT) signed with one of the algorithms e.g. RS256.
+func IsJWTAlgorithm(str string, algorithms ...string) bool {
+	alg, ok := jwtAlgorithm(str)
+	return ok && IsIn(alg, algorithms...)
+}
+
+// jwtAlgorithm returns the `alg` of the header of a JSON Web Token.
+func jwtAlgorithm(str string) (string, bool) {
+	segments := strings.Split(str, ".")
+	if len(segments) != 3 {
+		return "", false
+	}
+	var header struct {
+		Alg *string `json:"alg"`
+	}
+	var payload map[string]interface{}
+	if !decodeJWTSegment(segments[0], &header) || header.Alg == nil || !decodeJWTSegment(segments[1], &payload) {
+		return "", false
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(segments[2])
+	if err != nil || (len(signature) == 0) != (*header.Alg == "none") {
+		return "", false
+	}
+	return *header.Alg, true
+}
+
+// decodeJWTSegment decodes a base64url encoded JSON object segment into v.
+func decodeJWTSegment(segment string, v interface{}) bool {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil || !strings.HasPrefix(strings.TrimSpace(string(data)), "{") {
+		return false
+	}
+	return json.Unmarshal(data, v) == nil
+}
+
+func isJWTRaw(str string, params ...string) bool {
+	if len(params) != 1 {
+		return false
+	}
+	var algorithms []string
+	for _, option := range strings.Split(params[0], "|") {
+		if !strings.HasPrefix(option, "alg=") {
+			return false
+		}
+		algorithms = append(algorithms, strings.TrimPrefix(option, "alg="))
+	}
+	return IsJWTAlgorithm(str, algorithms...)
+}
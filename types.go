@@ -16,6 +16,10 @@ type CustomTypeValidator func(i interface{}, o interface{}) bool
 // ParamValidator is a wrapper for validator functions that accepts additional parameters.
 type ParamValidator func(str string, params ...string) bool
 
+// ParamErrorValidator is a wrapper for validator functions that accepts additional parameters
+// and returns why the string is invalid, which is used as the validation error message.
+type ParamErrorValidator func(str string, params ...string) error
+
 // Tag maps. Slices are used where order is needed and a map is used to map
 // a tag with it's custom error message (if provided).
 type tagMap []string
@@ -32,24 +36,128 @@ type stringValues []reflect.Value
 
 // ParamTagMap is a map of functions accept variants parameters
 var ParamTagMap = map[string]ParamValidator{
-	"length":       ByteLength,
-	"range":        Range,
-	"runelength":   RuneLength,
-	"stringlength": StringLength,
-	"matches":      StringMatches,
-	"in":           isInRaw,
-	"rsapub":       IsRsaPub,
+	"length":                 ByteLength,
+	"range":                  Range,
+	"min":                    Min,
+	"max":                    Max,
+	"gt":                     GreaterThan,
+	"gte":                    GreaterThanOrEqual,
+	"lt":                     LessThan,
+	"lte":                    LessThanOrEqual,
+	"runelength":             RuneLength,
+	"stringlength":           StringLength,
+	"matches":                StringMatches,
+	"in":                     isInRaw,
+	"email":                  isEmailRaw,
+	"url":                    isURLRaw,
+	"phone":                  isPhoneRaw,
+	"creditcard":             isCreditCardRaw,
+	"cvv":                    isCVVRaw,
+	"postcode":               isPostalCodeRaw,
+	"postcode_iso3166_field": isPostalCodeRaw,
+	"money":                  isMoneyRaw,
+	"money_iso4217_field":    isMoneyRaw,
+	"decimal":                isDecimalRaw,
+	"within_bbox":            isWithinBBoxRaw,
+	"semver_in":              isSemverInRaw,
+	"ip_in":                  isIPInRaw,
+	"cidr_within":            isCIDRWithinRaw,
+	"cidr_maxprefix":         isCIDRMaxPrefixRaw,
+	"uuid":                   isUUIDRaw,
+	"nanoid":                 IsNanoID,
+	"jwt":                    isJWTRaw,
+	"hash":                   isHashRaw,
+	"hex":                    isHexLengthRaw,
+	"base64":                 isBase64Raw,
+	"filepath":               isFilePathRaw,
+	"ext":                    isFileExtensionRaw,
+	"datauri":                isDataURIOfRaw,
+	"maxdecoded":             isMaxDecodedSizeRaw,
+	"rsapub":                 IsRsaPub,
+	"after":                  IsAfter,
+	"before":                 IsBefore,
+	"within":                 IsWithin,
+	"mindur":                 MinDuration,
+	"maxdur":                 MaxDuration,
+}
+
+// ParamTagErrorMap is a map of functions accept variants parameters and return why the value is invalid.
+// Their tags are matched using ParamTagRegexMap, just like the tags of ParamTagMap.
+var ParamTagErrorMap = map[string]ParamErrorValidator{
+	"password":  validatePasswordRaw,
+	"cert":      validateCertificateRaw,
+	"certchain": validateCertificateChainRaw,
+	"privkey":   validatePrivateKeyRaw,
+	"pubkey":    validatePublicKeyRaw,
+	"ecpub":     validateECPublicKeyRaw,
+	"sshkey":    validateAuthorizedKeyRaw,
+}
+
+// CrossFieldParamTags are the param tags in ParamTagMap whose first param is the name of a sibling field
+// e.g. `postcode_iso3166_field(Country)`. Their validator gets the value of the sibling field as the param.
+var CrossFieldParamTags = map[string]bool{
+	"postcode_iso3166_field": true,
+	"money_iso4217_field":    true,
+}
+
+// StringParamTags are the param tags in ParamTagMap which only apply to strings and types validated through their
+// text form, such as LatLng. Fields of other kinds fail with an error rather than on their value.
+var StringParamTags = map[string]bool{
+	"within_bbox": true,
 }
 
 // ParamTagRegexMap maps param tags to their respective regexes.
 var ParamTagRegexMap = map[string]*regexp.Regexp{
-	"range":        regexp.MustCompile("^range\\((\\d+)\\|(\\d+)\\)$"),
-	"length":       regexp.MustCompile("^length\\((\\d+)\\|(\\d+)\\)$"),
-	"runelength":   regexp.MustCompile("^runelength\\((\\d+)\\|(\\d+)\\)$"),
-	"stringlength": regexp.MustCompile("^stringlength\\((\\d+)\\|(\\d+)\\)$"),
-	"in":           regexp.MustCompile(`^in\((.*)\)`),
-	"matches":      regexp.MustCompile(`^matches\((.+)\)$`),
-	"rsapub":       regexp.MustCompile("^rsapub\\((\\d+)\\)$"),
+	"range":                  regexp.MustCompile("^range\\(" + numberParam + "\\|" + numberParam + "\\)$"),
+	"min":                    regexp.MustCompile("^min\\(" + numberParam + "\\)$"),
+	"max":                    regexp.MustCompile("^max\\(" + numberParam + "\\)$"),
+	"gt":                     regexp.MustCompile("^gt\\(" + numberParam + "\\)$"),
+	"gte":                    regexp.MustCompile("^gte\\(" + numberParam + "\\)$"),
+	"lt":                     regexp.MustCompile("^lt\\(" + numberParam + "\\)$"),
+	"lte":                    regexp.MustCompile("^lte\\(" + numberParam + "\\)$"),
+	"length":                 regexp.MustCompile("^length\\((\\d+)\\|(\\d+)\\)$"),
+	"runelength":             regexp.MustCompile("^runelength\\((\\d+)\\|(\\d+)\\)$"),
+	"stringlength":           regexp.MustCompile("^stringlength\\((\\d+)\\|(\\d+)\\)$"),
+	"in":                     regexp.MustCompile(`^in\((.*)\)`),
+	"email":                  regexp.MustCompile(`^email\((strict)\)$`),
+	"url":                    regexp.MustCompile(`^url\((.+)\)$`),
+	"phone":                  regexp.MustCompile(`^phone\(([a-zA-Z]{2})\)$`),
+	"creditcard":             regexp.MustCompile(`^creditcard\(([a-zA-Z|]+)\)$`),
+	"cvv":                    regexp.MustCompile(`^cvv\(([a-zA-Z]+)\)$`),
+	"postcode":               regexp.MustCompile(`^postcode\(([a-zA-Z]{2})\)$`),
+	"password":               regexp.MustCompile(`^password\(([\w-]+)\)$`),
+	"postcode_iso3166_field": regexp.MustCompile(`^postcode_iso3166_field\((\w+)\)$`),
+	"money":                  regexp.MustCompile(`^money\(([a-zA-Z]{3})\)$`),
+	"money_iso4217_field":    regexp.MustCompile(`^money_iso4217_field\((\w+)\)$`),
+	"decimal":                regexp.MustCompile(`^decimal\((\d+)\|(\d+)\)$`),
+	"within_bbox":            regexp.MustCompile("^within_bbox\\(" + numberParam + "\\|" + numberParam + "\\|" + numberParam + "\\|" + numberParam + "\\)$"),
+	"ip_in":                  regexp.MustCompile(`^ip_in\((.+)\)$`),
+	"cidr_within":            regexp.MustCompile(`^cidr_within\((.+)\)$`),
+	"cidr_maxprefix":         regexp.MustCompile(`^cidr_maxprefix\((\d+)\)$`),
+	"semver_in":              regexp.MustCompile(`^semver_in\((.+)\)$`),
+	"uuid":                   regexp.MustCompile(`^uuid\(([1-8i|]+)\)$`),
+	"nanoid":                 regexp.MustCompile(`^nanoid\((\d+)\)$`),
+	"jwt":                    regexp.MustCompile(`^jwt\((alg=[\w|=]+)\)$`),
+	"hash":                   regexp.MustCompile(`^hash\(([\w-]+(?:\|(?:lower|upper|any))?)\)$`),
+	"hex":                    regexp.MustCompile(`^hex\((\d+)\)$`),
+	"base64":                 regexp.MustCompile(`^base64\((strict)\)$`),
+	"filepath":               regexp.MustCompile(`^filepath(?:\((unix|windows)\))?$`),
+	"ext":                    regexp.MustCompile(`^ext\(([\w.|-]+)\)$`),
+	"datauri":                regexp.MustCompile(`^datauri\(([\w.+*/|-]+)\)$`),
+	"maxdecoded":             regexp.MustCompile(`^maxdecoded\((\d+)\)$`),
+	"matches":                regexp.MustCompile(`^matches\((.+)\)$`),
+	"rsapub":                 regexp.MustCompile("^rsapub\\((\\d+)\\)$"),
+	"cert":                   regexp.MustCompile(`^cert(?:\(([\w|-]+)\))?$`),
+	"certchain":              regexp.MustCompile(`^certchain(?:\(([\w|-]+)\))?$`),
+	"privkey":                regexp.MustCompile(`^privkey(?:\(([\w|-]+)\))?$`),
+	"pubkey":                 regexp.MustCompile(`^pubkey(?:\(([\w|-]+)\))?$`),
+	"ecpub":                  regexp.MustCompile(`^ecpub(?:\(([\w|-]+)\))?$`),
+	"sshkey":                 regexp.MustCompile(`^sshkey(?:\(([\w|-]+)\))?$`),
+	"after":                  regexp.MustCompile(`^after\((.+)\)$`),
+	"before":                 regexp.MustCompile(`^before\((.+)\)$`),
+	"within":                 regexp.MustCompile(`^within\((.+)\)$`),
+	"mindur":                 regexp.MustCompile(`^mindur\((.+)\)$`),
+	"maxdur":                 regexp.MustCompile(`^maxdur\((.+)\)$`),
 }
 
 type customTypeTagMap struct {
@@ -81,8 +189,14 @@ var TagMap = map[string]Validator{
 	"nonemptystring":     IsNonEmptyString,
 	"boolean":            IsBoolean,
 	"email":              IsEmail,
+	"existingemail":      IsExistingEmail,
 	"url":                IsURL,
 	"dialstring":         IsDialString,
+	"e164":               IsE164,
+	"iban":               IsIBAN,
+	"bic":                IsBIC,
+	"abarouting":         IsABARouting,
+	"uksortcode":         IsUKSortCode,
 	"requrl":             IsRequestURL,
 	"requri":             IsRequestURI,
 	"alpha":              IsAlpha,
@@ -104,7 +218,17 @@ var TagMap = map[string]Validator{
 	"uuidv3":             IsUUIDv3,
 	"uuidv4":             IsUUIDv4,
 	"uuidv5":             IsUUIDv5,
+	"uuidv6":             IsUUIDv6,
+	"uuidv7":             IsUUIDv7,
+	"uuidv8":             IsUUIDv8,
+	"ulid":               IsULID,
+	"ksuid":              IsKSUID,
+	"snowflake":          IsSnowflake,
+	"nanoid":             isNanoID,
+	"jwt":                IsJWT,
 	"creditcard":         IsCreditCard,
+	"cvv":                isCVV,
+	"cardexpiry":         IsCardExpiry,
 	"isbn10":             IsISBN10,
 	"isbn13":             IsISBN13,
 	"json":               IsJSON,
@@ -115,23 +239,62 @@ var TagMap = map[string]Validator{
 	"halfwidth":          IsHalfWidth,
 	"variablewidth":      IsVariableWidth,
 	"base64":             IsBase64,
+	"base64url":          IsBase64URL,
+	"base64rawurl":       IsBase64RawURL,
+	"base32":             IsBase32,
+	"base58":             IsBase58,
+	"filename":           IsFilename,
+	"relpath":            IsRelPath,
+	"abspath":            IsAbsPath,
 	"datauri":            IsDataURI,
+	"mimetype":           IsMIMEType,
+	"base64image":        IsBase64Image,
 	"ip":                 IsIP,
 	"port":               IsPort,
 	"ipv4":               IsIPv4,
 	"ipv6":               IsIPv6,
+	"ip_public":          IsPublicIP,
+	"ip_private":         IsPrivateIP,
+	"ip_loopback":        IsLoopbackIP,
+	"port_range":         IsPortRange,
+	"hostport":           IsHostPort,
 	"dns":                IsDNSName,
 	"host":               IsHost,
+	"domain":             IsDomainName,
+	"fqdn":               IsFQDN,
+	"registrable_domain": IsRegistrableDomain,
 	"mac":                IsMAC,
 	"latitude":           IsLatitude,
 	"longitude":          IsLongitude,
+	"latlng":             IsLatLng,
+	"geohash":            IsGeohash,
+	"geojson":            IsGeoJSON,
 	"ssn":                IsSSN,
+	"vat":                IsVAT,
+	"nino":               IsNINO,
+	"sin":                IsSIN,
+	"cpf":                IsCPF,
+	"cnpj":               IsCNPJ,
+	"pan":                IsPAN,
+	"dni":                IsDNI,
+	"nie":                IsNIE,
 	"semver":             IsSemver,
+	"semver_constraint":  IsSemverConstraint,
 	"rfc3339":            IsRFC3339,
 	"rfc3339WithoutZone": IsRFC3339WithoutZone,
 	"ISO3166Alpha2":      IsISO3166Alpha2,
 	"ISO3166Alpha3":      IsISO3166Alpha3,
+	"ISO3166Numeric":     IsISO3166Numeric,
+	"ISO3166Subdivision": IsISO3166Subdivision,
+	"ISO693Alpha2":       IsISO693Alpha2,
+	"ISO693Alpha3b":      IsISO693Alpha3b,
+	"ISO639Part3":        IsISO639Part3,
+	"bcp47":              IsBCP47,
 	"ISO4217":            IsISO4217,
+	"past":               IsPast,
+	"future":             IsFuture,
+	"weekday":            IsWeekday,
+	"notzero":            IsNotZeroTime,
 }
 
 // ISO3166Entry stores country codes
@@ -144,6 +307,7 @@ type ISO3166Entry struct {
 }
 
 //ISO3166List based on https://www.iso.org/obp/ui/#search/code/ Code Type "Officially Assigned Codes"
+// Entries can be appended (e.g. user-assigned codes) or the list replaced before validating, but not concurrently with it.
 var ISO3166List = []ISO3166Entry{
 	{"Afghanistan", "Afghanistan (l')", "AF", "AFG", "004"},
 	{"Albania", "Albanie (l')", "AL", "ALB", "008"},
@@ -396,34 +560,195 @@ var ISO3166List = []ISO3166Entry{
 	{"Zambia", "Zambie (la)", "ZM", "ZMB", "894"},
 }
 
-// ISO4217List is the list of ISO currency codes
-var ISO4217List = []string{
-	"AED", "AFN", "ALL", "AMD", "ANG", "AOA", "ARS", "AUD", "AWG", "AZN",
-	"BAM", "BBD", "BDT", "BGN", "BHD", "BIF", "BMD", "BND", "BOB", "BOV", "BRL", "BSD", "BTN", "BWP", "BYN", "BZD",
-	"CAD", "CDF", "CHE", "CHF", "CHW", "CLF", "CLP", "CNY", "COP", "COU", "CRC", "CUC", "CUP", "CVE", "CZK",
-	"DJF", "DKK", "DOP", "DZD",
-	"EGP", "ERN", "ETB", "EUR",
-	"FJD", "FKP",
-	"GBP", "GEL", "GHS", "GIP", "GMD", "GNF", "GTQ", "GYD",
-	"HKD", "HNL", "HRK", "HTG", "HUF",
-	"IDR", "ILS", "INR", "IQD", "IRR", "ISK",
-	"JMD", "JOD", "JPY",
-	"KES", "KGS", "KHR", "KMF", "KPW", "KRW", "KWD", "KYD", "KZT",
-	"LAK", "LBP", "LKR", "LRD", "LSL", "LYD",
-	"MAD", "MDL", "MGA", "MKD", "MMK", "MNT", "MOP", "MRO", "MUR", "MVR", "MWK", "MXN", "MXV", "MYR", "MZN",
-	"NAD", "NGN", "NIO", "NOK", "NPR", "NZD",
-	"OMR",
-	"PAB", "PEN", "PGK", "PHP", "PKR", "PLN", "PYG",
-	"QAR",
-	"RON", "RSD", "RUB", "RWF",
-	"SAR", "SBD", "SCR", "SDG", "SEK", "SGD", "SHP", "SLL", "SOS", "SRD", "SSP", "STD", "SVC", "SYP", "SZL",
-	"THB", "TJS", "TMT", "TND", "TOP", "TRY", "TTD", "TWD", "TZS",
-	"UAH", "UGX", "USD", "USN", "UYI", "UYU", "UZS",
-	"VEF", "VND", "VUV",
-	"WST",
-	"XAF", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "XCD", "XDR", "XOF", "XPD", "XPF", "XPT", "XSU", "XTS", "XUA", "XXX",
-	"YER",
-	"ZAR", "ZMW", "ZWL",
+// ISO4217Entry stores a currency code, its numeric code and its minor units (the number of
+// digits after the decimal separator, -1 if not applicable e.g. for gold)
+type ISO4217Entry struct {
+	Code       string
+	Numeric    string
+	MinorUnits int
+}
+
+// ISO4217List is the list of ISO currency codes, based on https://www.six-group.com/en/products-services/financial-information/data-standards.html
+// Entries can be appended (e.g. user-assigned codes) or the list replaced before validating, but not concurrently with it.
+var ISO4217List = []ISO4217Entry{
+	{"AED", "784", 2},
+	{"AFN", "971", 2},
+	{"ALL", "008", 2},
+	{"AMD", "051", 2},
+	{"ANG", "532", 2},
+	{"AOA", "973", 2},
+	{"ARS", "032", 2},
+	{"AUD", "036", 2},
+	{"AWG", "533", 2},
+	{"AZN", "944", 2},
+	{"BAM", "977", 2},
+	{"BBD", "052", 2},
+	{"BDT", "050", 2},
+	{"BGN", "975", 2},
+	{"BHD", "048", 3},
+	{"BIF", "108", 0},
+	{"BMD", "060", 2},
+	{"BND", "096", 2},
+	{"BOB", "068", 2},
+	{"BOV", "984", 2},
+	{"BRL", "986", 2},
+	{"BSD", "044", 2},
+	{"BTN", "064", 2},
+	{"BWP", "072", 2},
+	{"BYN", "933", 2},
+	{"BZD", "084", 2},
+	{"CAD", "124", 2},
+	{"CDF", "976", 2},
+	{"CHE", "947", 2},
+	{"CHF", "756", 2},
+	{"CHW", "948", 2},
+	{"CLF", "990", 4},
+	{"CLP", "152", 0},
+	{"CNY", "156", 2},
+	{"COP", "170", 2},
+	{"COU", "970", 2},
+	{"CRC", "188", 2},
+	{"CUC", "931", 2},
+	{"CUP", "192", 2},
+	{"CVE", "132", 2},
+	{"CZK", "203", 2},
+	{"DJF", "262", 0},
+	{"DKK", "208", 2},
+	{"DOP", "214", 2},
+	{"DZD", "012", 2},
+	{"EGP", "818", 2},
+	{"ERN", "232", 2},
+	{"ETB", "230", 2},
+	{"EUR", "978", 2},
+	{"FJD", "242", 2},
+	{"FKP", "238", 2},
+	{"GBP", "826", 2},
+	{"GEL", "981", 2},
+	{"GHS", "936", 2},
+	{"GIP", "292", 2},
+	{"GMD", "270", 2},
+	{"GNF", "324", 0},
+	{"GTQ", "320", 2},
+	{"GYD", "328", 2},
+	{"HKD", "344", 2},
+	{"HNL", "340", 2},
+	{"HRK", "191", 2},
+	{"HTG", "332", 2},
+	{"HUF", "348", 2},
+	{"IDR", "360", 2},
+	{"ILS", "376", 2},
+	{"INR", "356", 2},
+	{"IQD", "368", 3},
+	{"IRR", "364", 2},
+	{"ISK", "352", 0},
+	{"JMD", "388", 2},
+	{"JOD", "400", 3},
+	{"JPY", "392", 0},
+	{"KES", "404", 2},
+	{"KGS", "417", 2},
+	{"KHR", "116", 2},
+	{"KMF", "174", 0},
+	{"KPW", "408", 2},
+	{"KRW", "410", 0},
+	{"KWD", "414", 3},
+	{"KYD", "136", 2},
+	{"KZT", "398", 2},
+	{"LAK", "418", 2},
+	{"LBP", "422", 2},
+	{"LKR", "144", 2},
+	{"LRD", "430", 2},
+	{"LSL", "426", 2},
+	{"LYD", "434", 3},
+	{"MAD", "504", 2},
+	{"MDL", "498", 2},
+	{"MGA", "969", 2},
+	{"MKD", "807", 2},
+	{"MMK", "104", 2},
+	{"MNT", "496", 2},
+	{"MOP", "446", 2},
+	{"MRO", "478", 2},
+	{"MUR", "480", 2},
+	{"MVR", "462", 2},
+	{"MWK", "454", 2},
+	{"MXN", "484", 2},
+	{"MXV", "979", 2},
+	{"MYR", "458", 2},
+	{"MZN", "943", 2},
+	{"NAD", "516", 2},
+	{"NGN", "566", 2},
+	{"NIO", "558", 2},
+	{"NOK", "578", 2},
+	{"NPR", "524", 2},
+	{"NZD", "554", 2},
+	{"OMR", "512", 3},
+	{"PAB", "590", 2},
+	{"PEN", "604", 2},
+	{"PGK", "598", 2},
+	{"PHP", "608", 2},
+	{"PKR", "586", 2},
+	{"PLN", "985", 2},
+	{"PYG", "600", 0},
+	{"QAR", "634", 2},
+	{"RON", "946", 2},
+	{"RSD", "941", 2},
+	{"RUB", "643", 2},
+	{"RWF", "646", 0},
+	{"SAR", "682", 2},
+	{"SBD", "090", 2},
+	{"SCR", "690", 2},
+	{"SDG", "938", 2},
+	{"SEK", "752", 2},
+	{"SGD", "702", 2},
+	{"SHP", "654", 2},
+	{"SLL", "694", 2},
+	{"SOS", "706", 2},
+	{"SRD", "968", 2},
+	{"SSP", "728", 2},
+	{"STD", "678", 2},
+	{"SVC", "222", 2},
+	{"SYP", "760", 2},
+	{"SZL", "748", 2},
+	{"THB", "764", 2},
+	{"TJS", "972", 2},
+	{"TMT", "934", 2},
+	{"TND", "788", 3},
+	{"TOP", "776", 2},
+	{"TRY", "949", 2},
+	{"TTD", "780", 2},
+	{"TWD", "901", 2},
+	{"TZS", "834", 2},
+	{"UAH", "980", 2},
+	{"UGX", "800", 0},
+	{"USD", "840", 2},
+	{"USN", "997", 2},
+	{"UYI", "940", 0},
+	{"UYU", "858", 2},
+	{"UZS", "860", 2},
+	{"VEF", "937", 2},
+	{"VND", "704", 0},
+	{"VUV", "548", 0},
+	{"WST", "882", 2},
+	{"XAF", "950", 0},
+	{"XAG", "961", -1},
+	{"XAU", "959", -1},
+	{"XBA", "955", -1},
+	{"XBB", "956", -1},
+	{"XBC", "957", -1},
+	{"XBD", "958", -1},
+	{"XCD", "951", 2},
+	{"XDR", "960", -1},
+	{"XOF", "952", 0},
+	{"XPD", "964", -1},
+	{"XPF", "953", 0},
+	{"XPT", "962", -1},
+	{"XSU", "994", -1},
+	{"XTS", "963", -1},
+	{"XUA", "965", -1},
+	{"XXX", "999", -1},
+	{"YER", "886", 2},
+	{"ZAR", "710", 2},
+	{"ZMW", "967", 2},
+	{"ZWL", "932", 2},
 }
 
 // ISO693Entry stores ISO language codes
@@ -434,6 +759,7 @@ type ISO693Entry struct {
 }
 
 //ISO693List based on http://data.okfn.org/data/core/language-codes/r/language-codes-3b2.json
+// Entries can be appended (e.g. user-assigned codes) or the list replaced before validating, but not concurrently with it.
 var ISO693List = []ISO693Entry{
 	{Alpha3bCode: "aar", Alpha2Code: "aa", English: "Afar"},
 	{Alpha3bCode: "abk", Alpha2Code: "ab", English: "Abkhazian"},
@@ -0,0 +1,202 @@
+package govalidator
+
+import (
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// DefaultURLSchemes are the schemes allowed by a URLPolicy without Schemes.
+var DefaultURLSchemes = []string{"http", "https", "ftp", "tcp", "udp", "ws", "wss"}
+
+// URLPolicy validates absolute URLs (with a scheme and host) using net/url.
+// It's used by the `url(...)` tag whose `|` separated options set its fields:
+//
+//	url(schemes=https)            Schemes, separate multiple schemes with spaces e.g. schemes=http https
+//	url(notld=false)              RequireTLD, the host must be an IP or have a top level domain
+//	url(public)                   Public, the host must not be loopback, private (RFC 1918), link-local etc.
+//	url(maxlen=2048)              MaxLength in runes
+//	url(schemes=https|public)     Options can be combined
+//
+// RequireTLD is false by default, so e.g. url(schemes=https) accepts https://localhost or https://intranet,
+// use notld=false to require a top level domain (Public rejects localhost and the like too).
+//
+// Public only checks the URL itself. A public name can still resolve to a private IP,
+// so re-check the resolved addresses when connecting (e.g. in a net.Dialer Control func).
+type URLPolicy struct {
+	Schemes    []string
+	RequireTLD bool
+	Public     bool
+	MaxLength  int
+}
+
+// ParseURLPolicy returns the policy of the `url(...)` tag options e.g. `schemes=https|public`.
+// It returns false if an option is unknown or invalid.
+func ParseURLPolicy(options string) (*URLPolicy, bool) {
+	policy := &URLPolicy{}
+	for _, option := range strings.Split(options, "|") {
+		key, value := option, ""
+		if i := strings.Index(option, "="); i >= 0 {
+			key, value = option[:i], option[i+1:]
+		}
+		switch key {
+		case "schemes":
+			if strings.TrimSpace(value) == "" {
+				return nil, false
+			}
+			policy.Schemes = append(policy.Schemes, strings.Fields(strings.ToLower(value))...)
+		case "notld", "notls":
+			allow, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, false
+			}
+			policy.RequireTLD = !allow
+		case "public":
+			if value != "" {
+				return nil, false
+			}
+			policy.Public = true
+		case "maxlen":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return nil, false
+			}
+			policy.MaxLength = n
+		default:
+			return nil, false
+		}
+	}
+	return policy, true
+}
+
+// IsURL checks if the string is an absolute URL allowed by the policy.
+func (p *URLPolicy) IsURL(str string) bool {
+	if str == "" || p.MaxLength > 0 && utf8.RuneCountInString(str) > p.MaxLength {
+		return false
+	}
+	u, err := url.Parse(str)
+	if err != nil || u.Opaque != "" || !p.allowsScheme(u.Scheme) {
+		return false
+	}
+	if port := u.Port(); port != "" && !IsPort(port) {
+		return false
+	}
+
+	host := u.Hostname()
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return addr.Zone() == "" && (!p.Public || isPublicAddr(addr))
+	}
+	return p.allowsHostname(host)
+}
+
+func (p *URLPolicy) allowsScheme(scheme string) bool {
+	if scheme == "" {
+		return false
+	}
+	schemes := p.Schemes
+	if len(schemes) == 0 {
+		schemes = DefaultURLSchemes
+	}
+	return IsIn(strings.ToLower(scheme), schemes...)
+}
+
+// allowsHostname checks a (possibly IDN) hostname, names ending with a numeric
+// label are rejected as they may be parsed as an IP e.g. 0x7f.1 or 2130706433.
+func (p *URLPolicy) allowsHostname(host string) bool {
+	host = strings.TrimSuffix(host, ".")
+	if host == "" {
+		return false
+	}
+	labels := strings.Split(host, ".")
+	for i, label := range labels {
+		ascii, err := labelToASCII(label)
+		if err != nil {
+			return false
+		}
+		labels[i] = ascii
+	}
+	host = strings.Join(labels, ".")
+	if !IsDNSName(host) {
+		return false
+	}
+
+	tld := labels[len(labels)-1]
+	if tld[0] >= '0' && tld[0] <= '9' {
+		return false
+	}
+	if p.RequireTLD && len(labels) < 2 {
+		return false
+	}
+	if p.Public && (tld == "localhost" || tld == "local" || tld == "internal") {
+		return false
+	}
+	return true
+}
+
+// privateIPv4Prefixes are the IPv4 ranges not covered by netip's Is* methods
+// that should not be reached from a public URL.
+var privateIPv4Prefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),     // "this" network
+	netip.MustParsePrefix("100.64.0.0/10"), // carrier-grade NAT
+	netip.MustParsePrefix("192.0.0.0/24"),  // IETF protocol assignments
+	netip.MustParsePrefix("198.18.0.0/15"), // benchmarking
+	netip.MustParsePrefix("240.0.0.0/4"),   // reserved, including broadcast
+}
+
+// embeddedIPv4Prefixes are the IPv6 ranges which embed an IPv4 address at the given byte
+// offset, which must be public too e.g. 64:ff9b::a00:1 translates to private 10.0.0.1.
+var embeddedIPv4Prefixes = []struct {
+	prefix netip.Prefix
+	offset int
+}{
+	{netip.MustParsePrefix("64:ff9b::/96"), 12}, // NAT64
+	{netip.MustParsePrefix("2002::/16"), 2},     // 6to4
+}
+
+// privateIPv6Prefixes are the IPv6 ranges not covered by netip's Is* methods
+// that should not be reached from a public URL.
+var privateIPv6Prefixes = []netip.Prefix{
+	netip.MustParsePrefix("64:ff9b:1::/48"), // local-use NAT64
+}
+
+// isPublicAddr checks the address is globally reachable i.e. not loopback,
+// private, link-local, multicast, unspecified or otherwise reserved.
+func isPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+	if addr.Is4() {
+		for _, prefix := range privateIPv4Prefixes {
+			if prefix.Contains(addr) {
+				return false
+			}
+		}
+		return true
+	}
+	for _, prefix := range privateIPv6Prefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	for _, embedded := range embeddedIPv4Prefixes {
+		if embedded.prefix.Contains(addr) {
+			b := addr.As16()
+			var ipv4 [4]byte
+			copy(ipv4[:], b[embedded.offset:])
+			return isPublicAddr(netip.AddrFrom4(ipv4))
+		}
+	}
+	return true
+}
+
+func isURLRaw(str string, params ...string) bool {
+	if len(params) == 1 {
+		if policy, ok := ParseURLPolicy(params[0]); ok {
+			return policy.IsURL(str)
+		}
+	}
+	return false
+}
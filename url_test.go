@@ -0,0 +1,81 @@
+package govalidator
+
+import "testing"
+
+func TestURLPolicy(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		options  string
+		param    string
+		expected bool
+	}{
+		{"schemes=https", "https://example.com/hook", true},
+		{"schemes=https", "HTTPS://example.com", true},
+		{"schemes=https", "http://example.com/hook", false},
+		{"schemes=https", "example.com/hook", false},
+		{"schemes=https", "mailto:foo@example.com", false},
+		{"schemes=http https", "http://example.com", true},
+		{"schemes=ftp", "ftp://files.example.com:21/a.txt", true},
+		{"schemes=ftp", "ftp://files.example.com:99999/a.txt", false},
+		{"notld=true", "http://intranet/", true},
+		{"notld=false", "http://intranet/", false},
+		{"notls=false", "http://intranet/", false},
+		{"notld=false", "http://example.com", true},
+		{"notld=false", "http://10.0.0.1", true},
+		{"public", "https://example.com", true},
+		{"public", "https://müller.de/hook", true},
+		{"public", "https://8.8.8.8/hook", true},
+		{"public", "https://[2001:4860:4860::8888]/", true},
+		{"public", "http://127.0.0.1/", false},
+		{"public", "http://localhost:8080/", false},
+		{"public", "http://api.localhost/", false},
+		{"public", "http://10.1.2.3/", false},
+		{"public", "http://172.16.0.1/", false},
+		{"public", "http://192.168.1.1/", false},
+		{"public", "http://169.254.169.254/latest/meta-data/", false},
+		{"public", "http://0.0.0.0/", false},
+		{"public", "http://100.64.0.1/", false},
+		{"public", "http://[::1]/", false},
+		{"public", "http://[fe80::1]/", false},
+		{"public", "http://[fd00::1]/", false},
+		{"public", "http://[::ffff:127.0.0.1]/", false},
+		{"public", "http://[64:ff9b::a00:1]/", false},
+		{"public", "http://[64:ff9b::808:808]/", true},
+		{"public", "http://[64:ff9b:1::808:808]/", false},
+		{"public", "http://[2002:c0a8:101::1]/", false},
+		{"public", "http://[2002:808:808::1]/", true},
+		{"schemes=https", "https://localhost/", true},
+		{"schemes=https|notld=false", "https://localhost/", false},
+		{"public", "http://2130706433/", false},
+		{"public", "http://0x7f.1/", false},
+		{"maxlen=20", "http://example.com/", true},
+		{"maxlen=20", "http://example.com/abc", false},
+		{"schemes=https|public|maxlen=2048", "https://example.com/hook", true},
+		{"schemes=https|public|maxlen=2048", "https://192.168.0.1/hook", false},
+		{"unknown", "https://example.com", false},
+		{"maxlen=abc", "https://example.com", false},
+		{"public", "", false},
+		{"public", "http://", false},
+		{"public", "http://exa mple.com", false},
+	}
+	for _, test := range tests {
+		actual := isURLRaw(test.param, test.options)
+		if actual != test.expected {
+			t.Errorf("Expected url(%s)(%q) to be %v, got %v", test.options, test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestValidateURLPolicy(t *testing.T) {
+	type Webhook struct {
+		URL string `valid:"url(schemes=https|public|maxlen=2048)"`
+	}
+
+	if ok, err := Validate(Webhook{"http://169.254.169.254/"}); ok {
+		t.Errorf("Expected url(...) to fail, got %v", err)
+	}
+	if ok, err := Validate(Webhook{"https://example.com/hook"}); !ok {
+		t.Errorf("Expected url(...) to pass, got %v", err)
+	}
+}
@@ -173,14 +173,17 @@ func SafeFileName(str string) string {
 // Normalization follows special rules for known providers: currently, GMail addresses have dots removed in the local part and
 // are stripped of tags (e.g. some.one+tag@gmail.com becomes someone@gmail.com) and all @googlemail.com addresses are
 // normalized to @gmail.com.
+// A quoted local part (e.g. "John Doe"@example.com) is left as is.
 func NormalizeEmail(str string) (string, error) {
-	if !IsEmail(str) {
+	email, err := parseEmail(str, false)
+	if err != nil {
 		return "", fmt.Errorf("%s is not an email", str)
 	}
-	parts := strings.Split(str, "@")
-	parts[0] = strings.ToLower(parts[0])
-	parts[1] = strings.ToLower(parts[1])
-	if parts[1] == "gmail.com" || parts[1] == "googlemail.com" {
+	parts := []string{email.LocalPart, strings.ToLower(email.Domain)}
+	if !email.Quoted {
+		parts[0] = strings.ToLower(parts[0])
+	}
+	if !email.Quoted && (parts[1] == "gmail.com" || parts[1] == "googlemail.com") {
 		parts[1] = "gmail.com"
 		parts[0] = strings.Split(ReplacePattern(parts[0], `\.`, ""), "+")[0]
 	}
@@ -396,7 +396,9 @@ func TestNormalizeEmail(t *testing.T) {
 		{`some.name.midd.lena.me.+extension@gmail.com`, `somenamemiddlename@gmail.com`},
 		{`some.name.midd.lena.me.+extension@googlemail.com`, `somenamemiddlename@gmail.com`},
 		{`some.name+extension@unknown.com`, `some.name+extension@unknown.com`},
-		// TODO: {`hans@m端ller.com`, `hans@m端ller.com`},
+		{`hans@m端ller.com`, `hans@m端ller.com`},
+		{`Hans@Example.COM`, `hans@example.com`},
+		{`"Some.Name+Tag"@gmail.com`, `"Some.Name+Tag"@gmail.com`},
 		{`hans`, ``},
 	}
 	for _, test := range tests {
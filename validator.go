@@ -3,8 +3,11 @@ package govalidator
 
 import (
 	"bytes"
+	"context"
 	"crypto/rsa"
 	"crypto/x509"
+	"database/sql/driver"
+	"encoding"
 	"encoding/base64"
 	"encoding/json"
 	"encoding/pem"
@@ -24,17 +27,26 @@ import (
 
 var (
 	fieldsRequiredByDefault bool
+	stopOnFirstError        bool
+	maxErrors               int
+	errorsCount             int
 	notNumberRegexp         = regexp.MustCompile("[^0-9]+")
 	whiteSpacesAndMinus     = regexp.MustCompile("[\\s-]+")
 	paramsRegexp            = regexp.MustCompile("\\(.*\\)$")
 	errorsMap               map[string][]string
+	invalidParams           []InvalidParam
+	fieldPath               []string
 	tags                    tagMap
 	msgs                    tagCustomMsgMap
+	valuerType              = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+	textMarshalerType       = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	timeType                = reflect.TypeOf(time.Time{})
 )
 
 const maxURLRuneCount = 2083
 const minURLRuneCount = 3
 const RF3339WithoutZone = "2006-01-02T15:04:05"
+const dateLayout = "2006-01-02"
 
 // SetFieldsRequiredByDefault causes validation to fail when struct fields
 // do not include validations or are not explicitly marked as exempt (using `valid:"-"` or `valid:"email,optional"`).
@@ -54,46 +66,39 @@ func SetFieldsRequiredByDefault(value bool) {
 	fieldsRequiredByDefault = value
 }
 
+// SetStopOnFirstError causes validation to stop at the first invalid field,
+// skipping the validators of that field which haven't run yet and any fields after it.
+// Use the `stoponfirsterror` tag to only stop at the first failing validator of a single field e.g.
+//     type exampleStruct struct {
+//         Email string `valid:"stoponfirsterror,required,email,length(6|64)"`
+func SetStopOnFirstError(value bool) {
+	stopOnFirstError = value
+}
+
+// SetMaxErrors causes Validate to stop once n errors have been found.
+// Useful when validating huge payloads from untrusted sources. Zero (the default) means no limit.
+func SetMaxErrors(n int) {
+	maxErrors = n
+}
+
 // IsEmail check if the string is an email.
+// See ParseEmail for the reason an email is invalid and IsEmailStrict for stricter checks.
 func IsEmail(str string) bool {
-	// TODO uppercase letters are not supported
-	return rxEmail.MatchString(str)
+	_, err := parseEmail(str, false)
+	return err == nil
 }
 
 // IsExistingEmail checks if the string is an email of existing domain.
-// Requires a network/Internet connection. Func and tests will fail otherwise.
+// The domain is looked up using DefaultEmailVerifier, which requires a network/Internet connection
+// unless its Resolver is replaced (e.g. with a fake in tests).
 func IsExistingEmail(email string) bool {
-	if len(email) < 6 || len(email) > 254 {
-		return false
-	}
-
-	at := strings.LastIndex(email, "@")
-	if at <= 0 || at > len(email)-3 {
-		return false
-	}
-
-	user := email[:at]
-	host := email[at+1:]
-	if len(user) > 64 {
-		return false
-	}
-
-	if userDotRegexp.MatchString(user) || !userRegexp.MatchString(user) || !hostRegexp.MatchString(host) {
-		return false
-	}
-
-	switch host {
-	case "localhost", "example.com":
-		return true
-	}
-
-	if _, err := net.LookupMX(host); err != nil {
-		if _, err := net.LookupIP(host); err != nil {
-			return false
-		}
-	}
+	return DefaultEmailVerifier.IsExistingEmail(email)
+}
 
-	return true
+// IsExistingEmailContext checks if the string is an email of existing domain using DefaultEmailVerifier.
+// The DNS lookups are cancelled when ctx is done.
+func IsExistingEmailContext(ctx context.Context, email string) bool {
+	return DefaultEmailVerifier.IsExistingEmailContext(ctx, email)
 }
 
 // IsURL check if the string is an URL.
@@ -355,15 +360,69 @@ func IsUUIDv5(str string) bool {
 	return rxUUID5.MatchString(str)
 }
 
+// IsUUIDv6 check if the string is a UUID version 6.
+func IsUUIDv6(str string) bool {
+	return rxUUID6.MatchString(str)
+}
+
+// IsUUIDv7 check if the string is a UUID version 7.
+func IsUUIDv7(str string) bool {
+	return rxUUID7.MatchString(str)
+}
+
+// IsUUIDv8 check if the string is a UUID version 8.
+func IsUUIDv8(str string) bool {
+	return rxUUID8.MatchString(str)
+}
+
 // IsUUID check if the string is a UUID (version 3, 4 or 5).
 func IsUUID(str string) bool {
 	return rxUUID.MatchString(str)
 }
 
-// IsCreditCard check if the string is a credit card.
+// IsUUIDVersion check if the string is a UUID of one of the versions (1 to 8, any version if none are given),
+// also accepting uppercase hex digits if ignoreCase is true.
+func IsUUIDVersion(str string, ignoreCase bool, versions ...int) bool {
+	if ignoreCase {
+		str = strings.ToLower(str)
+	}
+	if !rxUUID.MatchString(str) {
+		return false
+	}
+	if len(versions) == 0 {
+		return true
+	}
+	for _, version := range versions {
+		// Like IsUUIDv3, version 3 UUIDs aren't required to have the RFC 4122 variant.
+		if version >= 1 && version <= 8 && str[14] == byte('0'+version) && (version == 3 || strings.IndexByte("89ab", str[19]) >= 0) {
+			return true
+		}
+	}
+	return false
+}
+
+func isUUIDRaw(str string, params ...string) bool {
+	if len(params) != 1 {
+		return false
+	}
+	var ignoreCase bool
+	var versions []int
+	for _, param := range strings.Split(params[0], "|") {
+		if param == "i" {
+			ignoreCase = true
+		} else if version, err := strconv.Atoi(param); err == nil {
+			versions = append(versions, version)
+		} else {
+			return false
+		}
+	}
+	return IsUUIDVersion(str, ignoreCase, versions...)
+}
+
+// IsCreditCard check if the string is a credit card of a known brand (see CardBrand) with a valid Luhn checksum.
 func IsCreditCard(str string) bool {
 	sanitized := notNumberRegexp.ReplaceAllString(str, "")
-	if !rxCreditCard.MatchString(sanitized) {
+	if CardBrand(sanitized) == "" {
 		return false
 	}
 	var sum int64
@@ -516,51 +575,35 @@ func IsFilePath(str string) (bool, int) {
 
 // IsDataURI checks if a string is base64 encoded data URI such as an image
 func IsDataURI(str string) bool {
-	dataURI := strings.Split(str, ",")
-	if !rxDataURI.MatchString(dataURI[0]) {
+	header, data, ok := strings.Cut(str, ",")
+	if !ok || !rxDataURI.MatchString(header) {
 		return false
 	}
-	return IsBase64(dataURI[1])
+	return IsBase64(data)
 }
 
 // IsISO3166Alpha2 checks if a string is valid two-letter country code
 func IsISO3166Alpha2(str string) bool {
-	for _, entry := range ISO3166List {
-		if str == entry.Alpha2Code {
-			return true
-		}
-	}
-	return false
+	entry, ok := iso3166ByCode()[str]
+	return ok && entry.Alpha2Code == str
 }
 
 // IsISO3166Alpha3 checks if a string is valid three-letter country code
 func IsISO3166Alpha3(str string) bool {
-	for _, entry := range ISO3166List {
-		if str == entry.Alpha3Code {
-			return true
-		}
-	}
-	return false
+	entry, ok := iso3166ByCode()[str]
+	return ok && entry.Alpha3Code == str
 }
 
 // IsISO693Alpha2 checks if a string is valid two-letter language code
 func IsISO693Alpha2(str string) bool {
-	for _, entry := range ISO693List {
-		if str == entry.Alpha2Code {
-			return true
-		}
-	}
-	return false
+	entry, ok := iso693ByCode()[str]
+	return ok && entry.Alpha2Code == str
 }
 
 // IsISO693Alpha3b checks if a string is valid three-letter language code
 func IsISO693Alpha3b(str string) bool {
-	for _, entry := range ISO693List {
-		if str == entry.Alpha3bCode {
-			return true
-		}
-	}
-	return false
+	entry, ok := iso693ByCode()[str]
+	return ok && entry.Alpha3bCode == str
 }
 
 // IsDNSName will validate the given string as a DNS name
@@ -572,31 +615,9 @@ func IsDNSName(str string) bool {
 	return !IsIP(str) && rxDNSName.MatchString(str)
 }
 
-// IsHash checks if a string is a hash of type algorithm.
-// Algorithm is one of ['md4', 'md5', 'sha1', 'sha256', 'sha384', 'sha512', 'ripemd128', 'ripemd160', 'tiger128', 'tiger160', 'tiger192', 'crc32', 'crc32b']
+// IsHash checks if a string is a lowercase hex digest of the algorithm e.g. sha256, see HashLengths for the algorithms.
 func IsHash(str string, algorithm string) bool {
-	len := "0"
-	algo := strings.ToLower(algorithm)
-
-	if algo == "crc32" || algo == "crc32b" {
-		len = "8"
-	} else if algo == "md5" || algo == "md4" || algo == "ripemd128" || algo == "tiger128" {
-		len = "32"
-	} else if algo == "sha1" || algo == "ripemd160" || algo == "tiger160" {
-		len = "40"
-	} else if algo == "tiger192" {
-		len = "48"
-	} else if algo == "sha256" {
-		len = "64"
-	} else if algo == "sha384" {
-		len = "96"
-	} else if algo == "sha512" {
-		len = "128"
-	} else {
-		return false
-	}
-
-	return Matches(str, "^[a-f0-9]{"+len+"}$")
+	return IsHashCase(str, algorithm, HashLowerCase)
 }
 
 // IsDialString validates the given string for usage with the various Dial() functions
@@ -732,6 +753,9 @@ func toJSONName(tag string) string {
 // for easy post processing e.g. JSON marshalling etc.
 func Validate(i interface{}) (bool, map[string]map[string][]string) {
 	errorsMap = make(map[string][]string, 0)
+	invalidParams = make([]InvalidParam, 0)
+	fieldPath = nil
+	errorsCount = 0
 	valid, _ := validateStruct(i)
 	removeDuplicateErrors()
 	return valid, allErrors()
@@ -759,6 +783,10 @@ func validateStruct(s interface{}) (bool, error) {
 
 	var errs Errors
 	for i := 0; i < val.NumField(); i++ {
+		if validationHalted(result) {
+			break
+		}
+
 		valueField := val.Field(i)
 		typeField := val.Type().Field(i)
 		validTag := typeField.Tag.Get(tagName)
@@ -767,11 +795,17 @@ func validateStruct(s interface{}) (bool, error) {
 			continue // Private field.
 		}
 
+		fieldName := toJSONName(typeField.Tag.Get("json"))
+		if fieldName == "" {
+			fieldName = typeField.Name
+		}
+		pushFieldPath(fieldName)
+
 		structResult := true
 
 		// If `valid` isn't "-" and concrete field is a struct.
-		if validTag != "-" && (valueField.Kind() == reflect.Struct ||
-			(valueField.Kind() == reflect.Ptr && valueField.Elem().Kind() == reflect.Struct)) {
+		if validTag != "-" && (isTraversableStruct(valueField) ||
+			(valueField.Kind() == reflect.Ptr && isTraversableStruct(valueField.Elem()))) {
 			var err error
 			structResult, err = validateStruct(valueField.Interface())
 			if err != nil {
@@ -803,6 +837,7 @@ func validateStruct(s interface{}) (bool, error) {
 
 			errs = append(errs, NewError(err2))
 		}
+		popFieldPath()
 
 		result = result && resultField && structResult
 	}
@@ -826,6 +861,8 @@ func validateField(v reflect.Value, t reflect.StructField, o reflect.Value, isRo
 
 	tag := t.Tag.Get(tagName) // `valid`
 	jsonTag := t.Tag.Get(`json`)
+	_, failFast := msgs["stoponfirsterror"]
+	failFast = failFast || stopOnFirstError
 
 	// Check if the field should be ignored: `valid:""` or `valid:"-"` tags.
 	switch tag {
@@ -840,9 +877,14 @@ func validateField(v reflect.Value, t reflect.StructField, o reflect.Value, isRo
 		return true, nil
 	}
 
+	// Custom type validators get the field as is, whereas the other validators
+	// get the value stored by driver.Valuer types such as sql.NullString.
+	fieldValue := v
+	v = driverValue(v)
+
 	// Presence validation; if the value is empty, process the `required`
 	// and `optional` tags otherwise process the `forbidden` tag.
-	if isEmptyValue(v) {
+	if isEmptyField(fieldValue) {
 		// Process `required` and `optional` tags.
 		if tempIsValid, tempError := checkRequired(v, t, msgs); !tempIsValid && tempError != nil {
 			validResult = false
@@ -869,27 +911,28 @@ func validateField(v reflect.Value, t reflect.StructField, o reflect.Value, isRo
 
 	var customTypeErrors Errors
 	for _, tag := range tags {
+		if failFast && (err != nil || len(customTypeErrors) > 0) || validationHalted(true) {
+			break
+		}
 		customErrorMessage := msgs[tag]
 		if validatefunc, ok := CustomTypeTagMap.Get(tag); ok {
 			deleteTagAndMsg(tag)
 
-			if result := validatefunc(v.Interface(), o.Interface()); !result {
+			if result := validatefunc(fieldValue.Interface(), o.Interface()); !result {
+				customErr := Error{Name: t.Name, Err: fmt.Errorf("%s does not validate as %s", fmt.Sprint(fieldValue), tag), CustomErrorMessageExists: false, Validator: stripParams(tag)}
 				if len(customErrorMessage) > 0 {
-					customTypeErrors = append(customTypeErrors, Error{Name: t.Name, Err: fmt.Errorf(customErrorMessage), CustomErrorMessageExists: true, Validator: stripParams(tag)})
-					continue
+					customErr = Error{Name: t.Name, Err: fmt.Errorf(customErrorMessage), CustomErrorMessageExists: true, Validator: stripParams(tag)}
+				}
+				customTypeErrors = append(customTypeErrors, customErr)
+				// Add to the map of all validation errors in the struct, counting towards MaxErrors.
+				if firstErr == nil {
+					firstErr = customErr.Err
 				}
-				customTypeErrors = append(customTypeErrors, Error{Name: t.Name, Err: fmt.Errorf("%s does not validate as %s", fmt.Sprint(v), tag), CustomErrorMessageExists: false, Validator: stripParams(tag)})
+				appendErrorsMap(jsonTag, customErr)
 			}
 		}
 	}
 	if len(customTypeErrors) > 0 {
-		for _, customErr := range customTypeErrors {
-			// Add to the map of all validation errors in the struct.
-			if firstErr == nil {
-				firstErr = customErr.Err
-			}
-			appendErrorsMap(jsonTag, customErr)
-		}
 		return false, customTypeErrors
 	}
 
@@ -899,6 +942,7 @@ func validateField(v reflect.Value, t reflect.StructField, o reflect.Value, isRo
 			deleteTagAndMsg("optional")
 			deleteTagAndMsg("required")
 			deleteTagAndMsg("forbidden")
+			deleteTagAndMsg("stoponfirsterror")
 
 			if isValid && resultErr == nil && len(tags) != 0 {
 				for _, validator := range tags {
@@ -911,6 +955,9 @@ func validateField(v reflect.Value, t reflect.StructField, o reflect.Value, isRo
 		}()
 	}
 
+	// Validate time.Time, net.IP, []byte etc. as strings e.g. `past` or `json`.
+	v = textValue(v)
+
 	switch v.Kind() {
 	case reflect.Bool,
 		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
@@ -941,12 +988,18 @@ func validateField(v reflect.Value, t reflect.StructField, o reflect.Value, isRo
 				}
 
 				validatefunc, ok := ParamTagMap[key]
-				if !ok {
+				reasonfunc, hasReason := ParamTagErrorMap[key]
+				if !ok && !hasReason {
 					continue
 				}
 
 				deleteTagAndMsg(tag)
 
+				if StringParamTags[key] && v.Kind() != reflect.String {
+					validResult, err = false, Error{t.Name, fmt.Errorf("Validator %s doesn't support kind %s", validator, v.Kind()), false, stripParams(validatorSpec)}
+					continue
+				}
+
 				switch v.Kind() {
 				case reflect.String,
 					reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
@@ -954,9 +1007,23 @@ func validateField(v reflect.Value, t reflect.StructField, o reflect.Value, isRo
 					reflect.Float32, reflect.Float64:
 
 					field := fmt.Sprint(v) // make value into string, then validate with regex
-					if result := validatefunc(field, ps[1:]...); (!result && !negate) || (result && negate) {
+					params := ps[1:]
+					if CrossFieldParamTags[key] {
+						params = append([]string{siblingValue(o, params[0])}, params[1:]...)
+					}
+					var result bool
+					var reason error
+					if hasReason {
+						reason = reasonfunc(field, params...)
+						result = reason == nil
+					} else {
+						result = validatefunc(field, params...)
+					}
+					if (!result && !negate) || (result && negate) {
 						if customMsgExists {
 							validResult, err = false, Error{t.Name, fmt.Errorf(customErrorMessage), customMsgExists, stripParams(validatorSpec)}
+						} else if reason != nil {
+							validResult, err = false, Error{t.Name, reason, customMsgExists, stripParams(validatorSpec)}
 						} else {
 							validResult, err = false, Error{t.Name, fmt.Errorf("%s does not validate as %s", field, validator), customMsgExists, stripParams(validatorSpec)}
 						}
@@ -998,7 +1065,14 @@ func validateField(v reflect.Value, t reflect.StructField, o reflect.Value, isRo
 				if firstErr == nil {
 					firstErr = err
 				}
-				appendErrorsMap(jsonTag, NewError(err))
+				if e, ok := err.(Error); ok {
+					appendErrorsMap(jsonTag, e)
+				} else {
+					appendErrorsMap(jsonTag, NewError(err))
+				}
+				if failFast || validationHalted(false) {
+					break
+				}
 			}
 		}
 
@@ -1016,18 +1090,20 @@ func validateField(v reflect.Value, t reflect.StructField, o reflect.Value, isRo
 		sort.Sort(sv)
 		result := true
 		for _, k := range sv {
+			if validationHalted(result) {
+				break
+			}
 			var resultItem bool
 			var err error
-			if v.MapIndex(k).Kind() != reflect.Struct {
+			pushFieldPath(k.String())
+			if !isTraversableStruct(v.MapIndex(k)) {
 				resultItem, err = validateField(v.MapIndex(k), t, o, false)
-				if err != nil {
-					return false, err
-				}
 			} else {
 				resultItem, err = validateStruct(v.MapIndex(k).Interface())
-				if err != nil {
-					return false, err
-				}
+			}
+			popFieldPath()
+			if err != nil {
+				return false, err
 			}
 			result = result && resultItem
 		}
@@ -1035,18 +1111,20 @@ func validateField(v reflect.Value, t reflect.StructField, o reflect.Value, isRo
 	case reflect.Slice, reflect.Array:
 		result := true
 		for i := 0; i < v.Len(); i++ {
+			if validationHalted(result) {
+				break
+			}
 			var resultItem bool
 			var err error
-			if v.Index(i).Kind() != reflect.Struct {
+			pushFieldPath(fmt.Sprintf("[%d]", i))
+			if !isTraversableStruct(v.Index(i)) {
 				resultItem, err = validateField(v.Index(i), t, o, false)
-				if err != nil {
-					return false, err
-				}
 			} else {
 				resultItem, err = validateStruct(v.Index(i).Interface())
-				if err != nil {
-					return false, err
-				}
+			}
+			popFieldPath()
+			if err != nil {
+				return false, err
 			}
 			result = result && resultItem
 		}
@@ -1082,7 +1160,40 @@ func appendErrorsMap(attr string, err Error) {
 	attr = toJSONName(attr)
 	errMsg := err.Error()
 
+	if !IsIn(errMsg, errorsMap[attr]...) {
+		errorsCount++
+	}
 	errorsMap[attr] = append(errorsMap[attr], errMsg)
+	appendInvalidParam(InvalidParam{Name: currentFieldPath(), Reason: errMsg, Code: err.Validator})
+}
+
+// validationHalted reports whether the traversal should stop early because of
+// the StopOnFirstError or MaxErrors options.
+func validationHalted(valid bool) bool {
+	return (stopOnFirstError && !valid) || (maxErrors > 0 && errorsCount >= maxErrors)
+}
+
+func pushFieldPath(segment string) {
+	fieldPath = append(fieldPath, segment)
+}
+
+func popFieldPath() {
+	if len(fieldPath) > 0 {
+		fieldPath = fieldPath[:len(fieldPath)-1]
+	}
+}
+
+// currentFieldPath joins the field path e.g. []string{"work", "[0]", "zip"}
+// becomes "work[0].zip".
+func currentFieldPath() string {
+	var path string
+	for _, segment := range fieldPath {
+		if path != "" && !strings.HasPrefix(segment, "[") {
+			path += "."
+		}
+		path += segment
+	}
+	return path
 }
 
 func removeDuplicateErrors() {
@@ -1151,12 +1262,14 @@ func isValidTag(s string) bool {
 	return true
 }
 
-// IsSSN will validate the given string as a U.S. Social Security Number
+// IsSSN will validate the given string as a U.S. Social Security Number.
+// The reserved area numbers (000, 666 and 900-999), group number 00 and serial number 0000 are invalid.
 func IsSSN(str string) bool {
-	if str == "" || len(str) != 11 {
+	if str == "" || len(str) != 11 || !rxSSN.MatchString(str) {
 		return false
 	}
-	return rxSSN.MatchString(str)
+	area, group, serial := str[0:3], str[4:6], str[7:11]
+	return area != "000" && area != "666" && area[0] != '9' && group != "00" && serial != "0000"
 }
 
 // IsSemver check if string is valid semantic version
@@ -1182,13 +1295,8 @@ func IsRFC3339WithoutZone(str string) bool {
 
 // IsISO4217 check if string is valid ISO currency code
 func IsISO4217(str string) bool {
-	for _, currency := range ISO4217List {
-		if str == currency {
-			return true
-		}
-	}
-
-	return false
+	_, ok := iso4217ByCode()[str]
+	return ok
 }
 
 // ByteLength check string's length
@@ -1241,18 +1349,89 @@ func StringLength(str string, params ...string) bool {
 	return false
 }
 
-// Range check string's length
+// Range check the number lies between min and max (inclusive). Signed and decimal borders are supported e.g. range(-0.5|10).
 func Range(str string, params ...string) bool {
 	if len(params) == 2 {
-		value, _ := ToFloat(str)
-		min, _ := ToFloat(params[0])
-		max, _ := ToFloat(params[1])
-		return InRange(value, min, max)
+		value, err := ToNumber(str)
+		if err != nil {
+			return false
+		}
+		min, err := ToNumber(params[0])
+		if err != nil {
+			return false
+		}
+		max, err := ToNumber(params[1])
+		if err != nil {
+			return false
+		}
+		if reflect.TypeOf(value) == reflect.TypeOf(min) && reflect.TypeOf(value) == reflect.TypeOf(max) {
+			return InRange(value, min, max)
+		}
+
+		// The value and borders are of different kinds e.g. an int with decimal borders.
+		if c, _ := CompareNumbers(min, max); c > 0 {
+			min, max = max, min
+		}
+		lower, okLower := CompareNumbers(value, min)
+		upper, okUpper := CompareNumbers(value, max)
+		return okLower && okUpper && lower >= 0 && upper <= 0
 	}
 
 	return false
 }
 
+// Min check the number is greater than or equal to min e.g. min(-10) or min(0.5)
+func Min(str string, params ...string) bool {
+	c, ok := compareNumberParam(str, params...)
+	return ok && c >= 0
+}
+
+// Max check the number is less than or equal to max e.g. max(10) or max(99.9)
+func Max(str string, params ...string) bool {
+	c, ok := compareNumberParam(str, params...)
+	return ok && c <= 0
+}
+
+// GreaterThan check the number is greater than the param
+func GreaterThan(str string, params ...string) bool {
+	c, ok := compareNumberParam(str, params...)
+	return ok && c > 0
+}
+
+// GreaterThanOrEqual check the number is greater than or equal to the param
+// Alias for Min
+func GreaterThanOrEqual(str string, params ...string) bool {
+	return Min(str, params...)
+}
+
+// LessThan check the number is less than the param
+func LessThan(str string, params ...string) bool {
+	c, ok := compareNumberParam(str, params...)
+	return ok && c < 0
+}
+
+// LessThanOrEqual check the number is less than or equal to the param
+// Alias for Max
+func LessThanOrEqual(str string, params ...string) bool {
+	return Max(str, params...)
+}
+
+// compareNumberParam compares the number str to the single number in params.
+func compareNumberParam(str string, params ...string) (int, bool) {
+	if len(params) != 1 {
+		return 0, false
+	}
+	value, err := ToNumber(str)
+	if err != nil {
+		return 0, false
+	}
+	param, err := ToNumber(params[0])
+	if err != nil {
+		return 0, false
+	}
+	return CompareNumbers(value, param)
+}
+
 func isInRaw(str string, params ...string) bool {
 	if len(params) == 1 {
 		rawParams := params[0]
@@ -1315,6 +1494,103 @@ func stripParams(validatorString string) string {
 	return paramsRegexp.ReplaceAllString(validatorString, "")
 }
 
+// isTraversableStruct reports whether v is a struct whose fields should be
+// validated, as opposed to a struct such as time.Time or sql.NullString
+// validated as a whole.
+func isTraversableStruct(v reflect.Value) bool {
+	if v.Kind() != reflect.Struct || v.Type() == timeType {
+		return false
+	}
+	return !v.Type().Implements(valuerType) && !reflect.PtrTo(v.Type()).Implements(valuerType)
+}
+
+// driverValue unwraps driver.Valuer types such as sql.NullString or
+// sql.NullInt64 into the value they store. A NULL value (i.e. Valid is false)
+// is unwrapped as an empty string.
+func driverValue(v reflect.Value) reflect.Value {
+	valuer, ok := asInterface(v, valuerType)
+	if !ok {
+		return v
+	}
+	value, err := valuer.(driver.Valuer).Value()
+	if err != nil || value == nil {
+		return reflect.ValueOf("")
+	}
+	return reflect.ValueOf(value)
+}
+
+// siblingValue returns the value of the named field of the struct o as a string,
+// or an empty string if there is no such field.
+func siblingValue(o reflect.Value, name string) string {
+	for o.Kind() == reflect.Ptr || o.Kind() == reflect.Interface {
+		if o.IsNil() {
+			return ""
+		}
+		o = o.Elem()
+	}
+	if o.Kind() != reflect.Struct {
+		return ""
+	}
+	field := o.FieldByName(name)
+	for field.Kind() == reflect.Ptr || field.Kind() == reflect.Interface {
+		if field.IsNil() {
+			return ""
+		}
+		field = field.Elem()
+	}
+	if !field.IsValid() {
+		return ""
+	}
+	return fmt.Sprint(textValue(driverValue(field)))
+}
+
+// textValue converts the types validated through their text form into
+// strings: []byte (e.g. json.RawMessage) and struct, array or []byte
+// encoding.TextMarshaler types (e.g. time.Time or net.IP). Types of other kinds, such as
+// an int enum with a MarshalText method, keep being validated by their kind.
+func textValue(v reflect.Value) reflect.Value {
+	isBytes := v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8
+	if v.Kind() == reflect.Struct || v.Kind() == reflect.Array || isBytes {
+		if marshaler, ok := asInterface(v, textMarshalerType); ok {
+			text, err := marshaler.(encoding.TextMarshaler).MarshalText()
+			if err != nil {
+				return reflect.ValueOf(fmt.Sprint(marshaler))
+			}
+			return reflect.ValueOf(string(text))
+		}
+		if isBytes {
+			return reflect.ValueOf(string(v.Bytes()))
+		}
+	}
+	return v
+}
+
+// asInterface returns v (or a pointer to it if addressable) if it implements iface.
+// Pointers and interfaces are left to be dereferenced by validateField first.
+func asInterface(v reflect.Value, iface reflect.Type) (interface{}, bool) {
+	if !v.IsValid() || v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface || !v.CanInterface() {
+		return nil, false
+	}
+	if v.Type().Implements(iface) {
+		return v.Interface(), true
+	}
+	if v.CanAddr() && reflect.PtrTo(v.Type()).Implements(iface) {
+		return v.Addr().Interface(), true
+	}
+	return nil, false
+}
+
+// isEmptyField reports whether a field is empty for `required`, `optional` and
+// `forbidden`. A driver.Valuer is only empty when NULL, so a valid zero such as
+// sql.NullInt64{Int64: 0, Valid: true} is present.
+func isEmptyField(v reflect.Value) bool {
+	if valuer, ok := asInterface(v, valuerType); ok {
+		value, err := valuer.(driver.Valuer).Value()
+		return err != nil || value == nil
+	}
+	return isEmptyValue(v)
+}
+
 func isEmptyValue(v reflect.Value) bool {
 	switch v.Kind() {
 	case reflect.String, reflect.Array:
@@ -1,7 +1,10 @@
 package govalidator
 
 import (
+	"database/sql"
 	"encoding/json"
+	"fmt"
+	"net"
 	"strings"
 	"testing"
 	"time"
@@ -637,7 +640,9 @@ func TestIsHash(t *testing.T) {
 }
 
 func TestIsExistingEmail(t *testing.T) {
-	t.Parallel()
+	defaultVerifier := DefaultEmailVerifier
+	DefaultEmailVerifier = NewEmailVerifier(newFakeResolver(), time.Second, time.Minute)
+	defer func() { DefaultEmailVerifier = defaultVerifier }()
 
 	var tests = []struct {
 		param    string
@@ -687,6 +692,13 @@ func TestIsEmail(t *testing.T) {
 		{"hans.m端ller@test.com", true},
 		{"NathAn.daVIeS@DomaIn.cOM", true},
 		{"NATHAN.DAVIES@DOMAIN.CO.UK", true},
+		{`"john doe"@example.com`, true},
+		{"john@[192.168.0.1]", true},
+		{"john@[IPv6:2001:db8::1]", true},
+		{"john@[300.168.0.1]", false},
+		{"john..doe@example.com", false},
+		{"john@localhost", false},
+		{"john@example.123", false},
 	}
 	for _, test := range tests {
 		actual := IsEmail(test.param)
@@ -1330,6 +1342,63 @@ func TestIsUUID(t *testing.T) {
 			t.Errorf("Expected IsUUIDv5(%q) to be %v, got %v", test.param, test.expected, actual)
 		}
 	}
+
+	// UUID ver. 6, 7 and 8
+	var versionTests = []struct {
+		validator func(string) bool
+		name      string
+		param     string
+		expected  bool
+	}{
+		{IsUUIDv6, "IsUUIDv6", "1ec9414c-232a-6b00-b3c8-9e6bdeced846", true},
+		{IsUUIDv6, "IsUUIDv6", "1ec9414c-232a-6b00-c3c8-9e6bdeced846", false},
+		{IsUUIDv6, "IsUUIDv6", "017f22e2-79b0-7cc3-98c4-dc0c0c07398f", false},
+		{IsUUIDv7, "IsUUIDv7", "017f22e2-79b0-7cc3-98c4-dc0c0c07398f", true},
+		{IsUUIDv7, "IsUUIDv7", "017F22E2-79B0-7CC3-98C4-DC0C0C07398F", false},
+		{IsUUIDv8, "IsUUIDv8", "320c3d4d-cc00-875b-8ec9-32d5f69181c0", true},
+		{IsUUIDv8, "IsUUIDv8", "320c3d4d-cc00-775b-8ec9-32d5f69181c0", false},
+	}
+	for _, test := range versionTests {
+		actual := test.validator(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected %s(%q) to be %v, got %v", test.name, test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestIsUUIDVersion(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param      string
+		ignoreCase bool
+		versions   []int
+		expected   bool
+	}{
+		{"017f22e2-79b0-7cc3-98c4-dc0c0c07398f", false, nil, true},
+		{"017f22e2-79b0-7cc3-98c4-dc0c0c07398f", false, []int{4, 7}, true},
+		{"017f22e2-79b0-7cc3-98c4-dc0c0c07398f", false, []int{4}, false},
+		{"017F22E2-79B0-7CC3-98C4-DC0C0C07398F", false, []int{7}, false},
+		{"017F22E2-79B0-7CC3-98C4-DC0C0C07398F", true, []int{7}, true},
+		{"017f22e2-79b0-7cc3-c8c4-dc0c0c07398f", false, []int{7}, false},
+		{"9c858901-8a57-4791-81fe-4c455b099bc9", false, []int{4}, true},
+		{"9c858901-8a57-4791-81fe-4c455b099bc9", false, []int{9}, false},
+		{"a987fbc9-4bed-3078-8f07-9141ba07c9f3", false, []int{3}, true},
+		{"a987fbc9-4bed-3078-cf07-9141ba07c9f3", false, []int{3}, true},
+		{"9c858901-8a57-4791-01fe-4c455b099bc9", false, []int{4}, false},
+	}
+	for _, test := range tests {
+		actual := IsUUIDVersion(test.param, test.ignoreCase, test.versions...)
+		if actual != test.expected {
+			t.Errorf("Expected IsUUIDVersion(%q, %v, %v) to be %v, got %v", test.param, test.ignoreCase, test.versions, test.expected, actual)
+		}
+		// The uuidvN validators check the version and variant in the same way.
+		if len(test.versions) == 1 && !test.ignoreCase {
+			if isVersion, ok := TagMap[fmt.Sprintf("uuidv%d", test.versions[0])]; ok && isVersion(test.param) != test.expected {
+				t.Errorf("Expected uuidv%d(%q) to be %v like IsUUIDVersion", test.versions[0], test.param, test.expected)
+			}
+		}
+	}
 }
 
 func TestIsCreditCard(t *testing.T) {
@@ -1448,6 +1517,7 @@ func TestIsDataURI(t *testing.T) {
 		{"data:image/png;base64,12345", false},
 		{"", false},
 		{"data:text,:;base85,U3VzcGVuZGlzc2UgbGVjdHVzIGxlbw==", false},
+		{"data:image/png;base64", false},
 	}
 	for _, test := range tests {
 		actual := IsDataURI(test.param)
@@ -1898,6 +1968,11 @@ func TestIsSSN(t *testing.T) {
 		{"66690-76", false},
 		{"191 60 2869", true},
 		{"191-60-2869", true},
+		{"000-60-2869", false},
+		{"666-60-2869", false},
+		{"900-60-2869", false},
+		{"191-00-2869", false},
+		{"191-60-0000", false},
 	}
 	for _, test := range tests {
 		actual := IsSSN(test.param)
@@ -2116,6 +2191,83 @@ func TestStringLength(t *testing.T) {
 	}
 }
 
+func TestRange(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		value    string
+		min      string
+		max      string
+		expected bool
+	}{
+		{"5", "1", "10", true},
+		{"1", "1", "10", true},
+		{"10", "1", "10", true},
+		{"11", "1", "10", false},
+		{"0", "-10", "10", true},
+		{"-10", "-10", "10", true},
+		{"-11", "-10", "10", false},
+		{"1", "0.5", "1.5", true},
+		{"2", "0.5", "1.5", false},
+		{"0.75", "0.5", "1.5", true},
+		{"5", "10", "1", true},
+		{"9223372036854775807", "9223372036854775806", "9223372036854775807", true},
+		{"9223372036854775806", "9223372036854775807", "18446744073709551615", false},
+		{"18446744073709551615", "0", "18446744073709551615", true},
+		{"abc", "-1", "1", false},
+		{"", "-1", "1", false},
+	}
+	for _, test := range tests {
+		actual := Range(test.value, test.min, test.max)
+		if actual != test.expected {
+			t.Errorf("Expected Range(%q, %q, %q) to be %v, got %v", test.value, test.min, test.max, test.expected, actual)
+		}
+	}
+}
+
+func TestNumericComparisons(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		value string
+		param string
+		min   bool
+		max   bool
+		gt    bool
+		lt    bool
+	}{
+		{"5", "5", true, true, false, false},
+		{"6", "5", true, false, true, false},
+		{"4", "5", false, true, false, true},
+		{"-1", "-0.5", false, true, false, true},
+		{"0.5", "-1", true, false, true, false},
+		{"9223372036854775807", "9223372036854775806", true, false, true, false},
+		{"18446744073709551615", "-9223372036854775808", true, false, true, false},
+		{"1e3", "999", true, false, true, false},
+		{"five", "5", false, false, false, false},
+	}
+	for _, test := range tests {
+		if actual := Min(test.value, test.param); actual != test.min {
+			t.Errorf("Expected Min(%q, %q) to be %v, got %v", test.value, test.param, test.min, actual)
+		}
+		if actual := GreaterThanOrEqual(test.value, test.param); actual != test.min {
+			t.Errorf("Expected GreaterThanOrEqual(%q, %q) to be %v, got %v", test.value, test.param, test.min, actual)
+		}
+		if actual := Max(test.value, test.param); actual != test.max {
+			t.Errorf("Expected Max(%q, %q) to be %v, got %v", test.value, test.param, test.max, actual)
+		}
+		if actual := LessThanOrEqual(test.value, test.param); actual != test.max {
+			t.Errorf("Expected LessThanOrEqual(%q, %q) to be %v, got %v", test.value, test.param, test.max, actual)
+		}
+		if actual := GreaterThan(test.value, test.param); actual != test.gt {
+			t.Errorf("Expected GreaterThan(%q, %q) to be %v, got %v", test.value, test.param, test.gt, actual)
+		}
+		if actual := LessThan(test.value, test.param); actual != test.lt {
+			t.Errorf("Expected LessThan(%q, %q) to be %v, got %v", test.value, test.param, test.lt, actual)
+		}
+	}
+}
+
 func TestIsIn(t *testing.T) {
 	t.Parallel()
 
@@ -2377,6 +2529,91 @@ func TestValidatePasses(t *testing.T) {
 	assert.JSONEq(t, expectedJSON, actualJSON)
 }
 
+func TestValidateStopOnFirstError(t *testing.T) {
+	SetStopOnFirstError(true)
+	defer SetStopOnFirstError(false)
+
+	person := Person{
+		Name:  `M`,
+		Email: `mick.com`,
+	}
+
+	valid, errs := Validate(person)
+
+	assert.False(t, valid)
+
+	jsonBytes, _ := json.Marshal(errs)
+	actualJSON := string(jsonBytes)
+	expectedJSON := `{"errors":{"name":["M does not validate as length(2|20)"]}}`
+
+	assert.JSONEq(t, expectedJSON, actualJSON)
+}
+
+func TestValidateStopOnFirstErrorTag(t *testing.T) {
+	type FailFast struct {
+		Name  string `valid:"stoponfirsterror,length(2|20),in(Mick|Michael)" json:"name"`
+		Email string `valid:"email" json:"email"`
+	}
+
+	valid, errs := Validate(FailFast{Name: `M`, Email: `mick.com`})
+
+	assert.False(t, valid)
+
+	jsonBytes, _ := json.Marshal(errs)
+	actualJSON := string(jsonBytes)
+	expectedJSON := `{"errors":{"email":["mick.com does not validate as email"],"name":["M does not validate as length(2|20)"]}}`
+
+	assert.JSONEq(t, expectedJSON, actualJSON)
+
+	valid, _ = Validate(FailFast{Name: `Mick`, Email: `mick@gmail.com`})
+
+	assert.True(t, valid)
+}
+
+func TestValidateMaxErrors(t *testing.T) {
+	SetMaxErrors(2)
+	defer SetMaxErrors(0)
+
+	person := Person{
+		Name:  `M`,
+		Email: `mick.com`,
+	}
+
+	valid, errs := Validate(person)
+
+	assert.False(t, valid)
+
+	jsonBytes, _ := json.Marshal(errs)
+	actualJSON := string(jsonBytes)
+	expectedJSON := `{"errors":{"name":["M does not validate as length(2|20)","M does not validate as in(Mick|Michael)"]}}`
+
+	assert.JSONEq(t, expectedJSON, actualJSON)
+}
+
+func TestValidateMaxErrorsCustomType(t *testing.T) {
+	SetMaxErrors(1)
+	defer SetMaxErrors(0)
+
+	CustomTypeTagMap.Set("customNameTaken", CustomTypeValidator(func(i interface{}, o interface{}) bool {
+		return false
+	}))
+
+	type Account struct {
+		Name  string `valid:"customFalseValidator~Name reserved,customNameTaken~Name taken" json:"name"`
+		Email string `valid:"customFalseValidator" json:"email"`
+	}
+
+	valid, errs := Validate(Account{Name: `Mick`, Email: `mick@gmail.com`})
+
+	assert.False(t, valid)
+
+	jsonBytes, _ := json.Marshal(errs)
+	actualJSON := string(jsonBytes)
+	expectedJSON := `{"errors":{"name":["Name reserved"]}}`
+
+	assert.JSONEq(t, expectedJSON, actualJSON)
+}
+
 // PersonWithPointer is used in TestValidatePointer* test cases below.
 type PersonWithPointer struct {
 	Name        *string `valid:"optional,length(2|20),in(Mick|Michael)" json:"name,omitempty"`
@@ -3131,6 +3368,40 @@ func TestValidateStructParamValidatorInt(t *testing.T) {
 	}
 }
 
+func TestValidateStructParamValidatorSignedAndDecimal(t *testing.T) {
+	type Test struct {
+		Temperature int     `valid:"range(-10|10)"`
+		Ratio       float64 `valid:"range(0.5|1.5)"`
+		Offset      int8    `valid:"min(-5),max(5)"`
+		Big         int64   `valid:"gt(9223372036854775806)"`
+		Huge        uint64  `valid:"gte(18446744073709551615)"`
+		Price       float32 `valid:"gt(0),lt(100.5)"`
+		Count       uint    `valid:"lte(3)"`
+	}
+
+	testOk := &Test{-10, 0.5, -5, 9223372036854775807, 18446744073709551615, 100.25, 3}
+	_, err := validateStruct(testOk)
+	if err != nil {
+		t.Errorf("Test failed: %s", err)
+	}
+
+	var tests = []Test{
+		{-11, 0.5, -5, 9223372036854775807, 18446744073709551615, 100.25, 3},
+		{-10, 1.51, -5, 9223372036854775807, 18446744073709551615, 100.25, 3},
+		{-10, 0.5, -6, 9223372036854775807, 18446744073709551615, 100.25, 3},
+		{-10, 0.5, -5, 9223372036854775806, 18446744073709551615, 100.25, 3},
+		{-10, 0.5, -5, 9223372036854775807, 18446744073709551614, 100.25, 3},
+		{-10, 0.5, -5, 9223372036854775807, 18446744073709551615, 100.5, 3},
+		{-10, 0.5, -5, 9223372036854775807, 18446744073709551615, 100.25, 4},
+	}
+	for _, test := range tests {
+		_, err = validateStruct(test)
+		if err == nil {
+			t.Errorf("Test failed: nil for %v", test)
+		}
+	}
+}
+
 func TestIsCIDR(t *testing.T) {
 	t.Parallel()
 
@@ -3177,6 +3448,124 @@ func TestJSONValidator(t *testing.T) {
 	}
 }
 
+type NullableContact struct {
+	Name    sql.NullString  `valid:"required,alpha" json:"name"`
+	Email   sql.NullString  `valid:"optional,email" json:"email"`
+	Age     sql.NullInt64   `valid:"optional,range(18|99)" json:"age"`
+	Website *sql.NullString `valid:"optional,url" json:"website"`
+}
+
+func TestValidateSQLNullTypes(t *testing.T) {
+	contact := NullableContact{
+		Name: sql.NullString{String: "Mick", Valid: true},
+		Age:  sql.NullInt64{Int64: 30, Valid: true},
+	}
+
+	valid, errs := Validate(contact)
+
+	assert.True(t, valid)
+	jsonBytes, _ := json.Marshal(errs)
+	assert.JSONEq(t, `{"errors":{}}`, string(jsonBytes))
+
+	contact = NullableContact{
+		Name:    sql.NullString{String: "Mick", Valid: false},
+		Email:   sql.NullString{String: "mick.com", Valid: true},
+		Age:     sql.NullInt64{Int64: 12, Valid: true},
+		Website: &sql.NullString{String: "not a url", Valid: true},
+	}
+
+	valid, errs = Validate(contact)
+
+	assert.False(t, valid)
+	jsonBytes, _ = json.Marshal(errs)
+	expectedJSON := `{"errors":{
+		"name":["non zero value required"],
+		"email":["mick.com does not validate as email"],
+		"age":["12 does not validate as range(18|99)"],
+		"website":["not a url does not validate as url"]
+	}}`
+	assert.JSONEq(t, expectedJSON, string(jsonBytes))
+}
+
+type Upload struct {
+	Metadata json.RawMessage `valid:"json" json:"metadata"`
+	Content  []byte          `valid:"base64" json:"content"`
+	Checksum []byte          `valid:"optional,hexadecimal,length(8|8)" json:"checksum"`
+	Origin   net.IP          `valid:"ipv4" json:"origin"`
+}
+
+func TestValidateTextTypes(t *testing.T) {
+	upload := Upload{
+		Metadata: json.RawMessage(`{"name":"avatar.png"}`),
+		Content:  []byte("aGVsbG8="),
+		Origin:   net.ParseIP("192.168.0.1"),
+	}
+
+	valid, errs := Validate(upload)
+
+	assert.True(t, valid)
+	jsonBytes, _ := json.Marshal(errs)
+	assert.JSONEq(t, `{"errors":{}}`, string(jsonBytes))
+
+	upload = Upload{
+		Metadata: json.RawMessage(`{"name":`),
+		Content:  []byte("hello"),
+		Checksum: []byte("abc"),
+		Origin:   net.ParseIP("::1"),
+	}
+
+	valid, errs = Validate(upload)
+
+	assert.False(t, valid)
+	jsonBytes, _ = json.Marshal(errs)
+	expectedJSON := `{"errors":{
+		"metadata":["{\"name\": does not validate as json"],
+		"content":["hello does not validate as base64"],
+		"checksum":["abc does not validate as length(8|8)"],
+		"origin":["::1 does not validate as ipv4"]
+	}}`
+	assert.JSONEq(t, expectedJSON, string(jsonBytes))
+}
+
+type TextLevel int
+
+func (l TextLevel) MarshalText() ([]byte, error) {
+	return []byte(strings.Repeat("x", int(l)) + "lvl"), nil
+}
+
+type TextAddress struct {
+	Street string `valid:"required" json:"street"`
+}
+
+func (a TextAddress) MarshalText() ([]byte, error) {
+	return []byte(a.Street), nil
+}
+
+type TextProfile struct {
+	Level   TextLevel     `valid:"range(0|5)" json:"level"`
+	Address TextAddress   `json:"address"`
+	Score   sql.NullInt64 `valid:"required" json:"score"`
+}
+
+func TestValidateTextMarshalerKinds(t *testing.T) {
+	valid, errs := Validate(TextProfile{Level: 2, Address: TextAddress{"Main St"}, Score: sql.NullInt64{Int64: 0, Valid: true}})
+
+	assert.True(t, valid)
+	jsonBytes, _ := json.Marshal(errs)
+	assert.JSONEq(t, `{"errors":{}}`, string(jsonBytes))
+
+	valid, errs = Validate(TextProfile{Level: 7})
+
+	assert.False(t, valid)
+	jsonBytes, _ = json.Marshal(errs)
+	expectedJSON := `{"errors":{
+		"level":["7 does not validate as range(0|5)"],
+		"street":["non zero value required"],
+		"score":["non zero value required"]
+	}}`
+	assert.JSONEq(t, expectedJSON, string(jsonBytes))
+}
+
 func TestIsRsaPublicKey(t *testing.T) {
 	var tests = []struct {
 		rsastr   string